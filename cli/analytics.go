@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// minCorrelationDays is how many days with both a mood log and productivity
+// data are needed before a mood/productivity correlation is shown
+const minCorrelationDays = 5
+
+// dayKey buckets a timestamp into a calendar day in the user's display
+// timezone, so every view agrees on where a day starts
+func (c *FocusForgeCLI) dayKey(t time.Time) string {
+	if c.location == nil {
+		return t.Local().Format(dateLayout)
+	}
+	return t.In(c.location).Format(dateLayout)
+}
+
+// dailyProductivity is the work done on a single day
+type dailyProductivity struct {
+	focusMinutes   int
+	tasksCompleted int
+}
+
+// feelingProductivity aggregates productivity over the days a feeling was logged
+type feelingProductivity struct {
+	feeling      string
+	days         int
+	focusMinutes int
+	tasks        int
+}
+
+func (c *FocusForgeCLI) showMoodProductivity() {
+	color.Cyan("🔗 Mood vs Productivity")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot analyze mood")
+		fmt.Println()
+		return
+	}
+
+	color.Yellow("Gathering mood logs, sessions and tasks...")
+
+	moodResp, err := c.apiClient.GetMoodLogs(200)
+	if err != nil {
+		color.Red("❌ Failed to fetch mood logs: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	sessionResp, err := c.apiClient.GetSessionHistory(200)
+	if err != nil {
+		color.Red("❌ Failed to fetch session history: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	taskResp, err := c.apiClient.GetTasks("completed", "", 200)
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	days := map[string]*dailyProductivity{}
+	day := func(key string) *dailyProductivity {
+		if days[key] == nil {
+			days[key] = &dailyProductivity{}
+		}
+		return days[key]
+	}
+	for _, session := range sessionResp.Sessions {
+		if t, ok := parseTimestamp(session.StartedAt); ok {
+			day(c.dayKey(t)).focusMinutes += session.ActualMinutes
+		}
+	}
+	for _, task := range taskResp.Tasks {
+		if t, ok := parseTimestamp(task.UpdatedAt); ok {
+			day(c.dayKey(t)).tasksCompleted++
+		}
+	}
+
+	// Join each day's moods with that day's productivity. A feeling logged
+	// several times on one day only counts that day once.
+	byFeeling := map[string]*feelingProductivity{}
+	seen := map[string]bool{}
+	intensityTotals := map[string]int{}
+	intensityCounts := map[string]int{}
+	for _, entry := range moodResp.MoodLogs {
+		t, ok := parseTimestamp(entry.Timestamp)
+		if !ok {
+			continue
+		}
+		key := c.dayKey(t)
+		if entry.Intensity > 0 {
+			intensityTotals[key] += entry.Intensity
+			intensityCounts[key]++
+		}
+
+		if seen[key+"|"+entry.Feeling] {
+			continue
+		}
+		seen[key+"|"+entry.Feeling] = true
+
+		stats := byFeeling[entry.Feeling]
+		if stats == nil {
+			stats = &feelingProductivity{feeling: entry.Feeling}
+			byFeeling[entry.Feeling] = stats
+		}
+		stats.days++
+		if d := days[key]; d != nil {
+			stats.focusMinutes += d.focusMinutes
+			stats.tasks += d.tasksCompleted
+		}
+	}
+
+	var intensities, minutes []float64
+	for key, count := range intensityCounts {
+		intensities = append(intensities, float64(intensityTotals[key])/float64(count))
+		focus := 0
+		if d := days[key]; d != nil {
+			focus = d.focusMinutes
+		}
+		minutes = append(minutes, float64(focus))
+	}
+
+	if len(seen) == 0 || len(intensityCounts) < minCorrelationDays {
+		color.Yellow("Not enough data yet - log your mood on at least %d days to see how it relates to your productivity.", minCorrelationDays)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	rows := make([]*feelingProductivity, 0, len(byFeeling))
+	for _, stats := range byFeeling {
+		rows = append(rows, stats)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].focusMinutes*rows[j].days > rows[j].focusMinutes*rows[i].days
+	})
+
+	fmt.Println()
+	fmt.Printf("%-14s %6s %14s %12s\n", "Feeling", "Days", "Avg Focus Min", "Avg Tasks")
+	fmt.Printf("%-14s %6s %14s %12s\n", "-------", "----", "-------------", "---------")
+	for _, stats := range rows {
+		fmt.Printf("%-14s %6d %14.0f %12.1f\n", stats.feeling, stats.days,
+			float64(stats.focusMinutes)/float64(stats.days),
+			float64(stats.tasks)/float64(stats.days))
+	}
+	fmt.Println()
+
+	if r, ok := pearson(intensities, minutes); ok {
+		fmt.Printf("Mood intensity vs focus minutes: r = %.2f ", r)
+		switch {
+		case r >= 0.3:
+			color.Green("(you tend to focus more on intense-mood days)")
+		case r <= -0.3:
+			color.Yellow("(you tend to focus less on intense-mood days)")
+		default:
+			color.Cyan("(no clear relationship)")
+		}
+	}
+
+	fmt.Println()
+	waitForEnter()
+}
+
+// pearson returns the correlation coefficient of xs and ys, or false when it
+// is undefined because there are too few points or no variance
+func pearson(xs, ys []float64) (float64, bool) {
+	n := float64(len(xs))
+	if len(xs) < 2 || len(xs) != len(ys) {
+		return 0, false
+	}
+
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX, varY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return 0, false
+	}
+
+	return cov / math.Sqrt(varX*varY), true
+}
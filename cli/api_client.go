@@ -2,27 +2,85 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"sync"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
+// defaultRequestTimeout is how long regular requests may take unless the
+// --timeout flag says otherwise
+const defaultRequestTimeout = 15 * time.Second
+
+// aiRequestTimeout is the timeout used for AI-backed endpoints, which can be
+// much slower than regular CRUD calls
+const aiRequestTimeout = 2 * time.Minute
+
 // APIClient handles communication with the FocusForge backend
 type APIClient struct {
 	baseURL    string
 	httpClient *http.Client
 	userID     string
+	token      string
+
+	// transport sits under httpClient to record request metrics when enabled
+	transport *metricsTransport
+
+	// timeout and aiTimeout bound each request through its context
+	timeout   time.Duration
+	aiTimeout time.Duration
+
+	// mu guards the settings below, which the settings menus may change
+	// while background goroutines are sending requests
+	mu sync.Mutex
+	// limiter caps how fast requests are sent; nil means no limit
+	limiter *rate.Limiter
+
+	// endpoints maps resource groups to base URLs that override baseURL
+	endpoints map[string]string
+
+	// retry controls automatic retries of failed requests
+	retry retryPolicy
 }
 
 // NewAPIClient creates a new API client
 func NewAPIClient(baseURL, userID string) *APIClient {
+	transport := &metricsTransport{next: http.DefaultTransport}
 	return &APIClient{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		userID: userID,
+		baseURL:    baseURL,
+		httpClient: &http.Client{Transport: transport},
+		transport:  transport,
+		userID:     userID,
+		timeout:    defaultRequestTimeout,
+		aiTimeout:  aiRequestTimeout,
+	}
+}
+
+// SetRateLimit caps requests at perSecond, letting short bursts of up to
+// perSecond requests through at once. Zero or less removes the limit.
+func (c *APIClient) SetRateLimit(perSecond int) {
+	var limiter *rate.Limiter
+	if perSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(perSecond), perSecond)
+	}
+	c.mu.Lock()
+	c.limiter = limiter
+	c.mu.Unlock()
+}
+
+// SetTimeout changes how long regular requests may take. AI requests keep
+// their longer timeout unless d exceeds it.
+func (c *APIClient) SetTimeout(d time.Duration) {
+	c.timeout = d
+	if d > c.aiTimeout {
+		c.aiTimeout = d
 	}
 }
 
@@ -35,8 +93,41 @@ type Task struct {
 	Category        string    `json:"category,omitempty"`
 	Priority        string    `json:"priority,omitempty"`
 	Status          string    `json:"status,omitempty"`
+	DueDate         string    `json:"due_date,omitempty"`
 	CreatedAt       string    `json:"created_at,omitempty"`
 	UpdatedAt       string    `json:"updated_at,omitempty"`
+	Blocks          []*TaskBlock `json:"blocks,omitempty"`
+	DependsOn       []string  `json:"depends_on,omitempty"`
+	SnoozedUntil    string    `json:"snoozed_until,omitempty"`
+	ColorLabel      string    `json:"color_label,omitempty"`
+	EffortPoints    int       `json:"effort_points,omitempty"`
+	Rating          int       `json:"rating,omitempty"`
+}
+
+// TaskBlock represents one focus block of a broken-down task
+type TaskBlock struct {
+	ID              string `json:"id"`
+	Title           string `json:"title"`
+	DurationMinutes int    `json:"duration_minutes"`
+	Status          string `json:"status,omitempty"`
+}
+
+// IsCompleted reports whether the block has been checked off
+func (b *TaskBlock) IsCompleted() bool {
+	return b.Status == "completed"
+}
+
+// TaskUpdateRequest represents a partial task update; empty fields are left unchanged
+type TaskUpdateRequest struct {
+	Title           string `json:"title,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DurationMinutes int    `json:"duration_minutes,omitempty"`
+	Category        string `json:"category,omitempty"`
+	Priority        string `json:"priority,omitempty"`
+	Status          string `json:"status,omitempty"`
+	DueDate         string `json:"due_date,omitempty"`
+	ClearDueDate    bool   `json:"clear_due_date,omitempty"` // removes the due date
+	Rating          int    `json:"rating,omitempty"`         // 1-5 stars, sent when completing
 }
 
 // TaskCreateRequest represents a task creation request
@@ -46,6 +137,12 @@ type TaskCreateRequest struct {
 	DurationMinutes int    `json:"duration_minutes"`
 	Category        string `json:"category,omitempty"`
 	Priority        string `json:"priority,omitempty"`
+	DependsOn       []string `json:"depends_on,omitempty"`
+	ColorLabel      string   `json:"color_label,omitempty"`
+	EffortPoints    int      `json:"effort_points,omitempty"`
+
+	// IdempotencyKey is sent as a header so a retried create isn't duplicated
+	IdempotencyKey string `json:"-"`
 }
 
 // TaskResponse represents the response from task operations
@@ -57,6 +154,21 @@ type TaskResponse struct {
 	Message string     `json:"message,omitempty"`
 	Count   int        `json:"count,omitempty"`
 	Stats   *TaskStats `json:"stats,omitempty"`
+
+	// Set when completing a task earned a reward
+	TokensEarned    int           `json:"tokens_earned,omitempty"`
+	NewAchievements []Achievement `json:"new_achievements,omitempty"`
+
+	// NextCursor is set when there are more tasks to fetch with GetTasksPage
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Achievement is a milestone the backend has just unlocked for the user
+type Achievement struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Reward      int    `json:"reward,omitempty"`
 }
 
 // TaskStats represents task statistics
@@ -69,6 +181,9 @@ type TaskStats struct {
 	TotalMinutes     int     `json:"total_minutes_planned"`
 	TotalTokens      int     `json:"total_tokens_earned"`
 	AvgDifficulty    float64 `json:"avg_difficulty"`
+	TotalEffort      int     `json:"total_effort_points"`
+	CompletedEffort  int     `json:"completed_effort_points"`
+	AvgRating        float64 `json:"avg_rating"`
 }
 
 // MoodLog represents a mood entry
@@ -85,6 +200,10 @@ type MoodLogRequest struct {
 	Feeling   string `json:"feeling"`
 	Intensity int    `json:"intensity,omitempty"`
 	Note      string `json:"note,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"` // set only when importing past entries
+
+	// IdempotencyKey is sent as a header so a replayed mood isn't logged twice
+	IdempotencyKey string `json:"-"`
 }
 
 // MoodResponse represents the response from mood operations
@@ -95,6 +214,9 @@ type MoodResponse struct {
 	Error     string    `json:"error,omitempty"`
 	Message   string    `json:"message,omitempty"`
 	Patterns  map[string]interface{} `json:"patterns,omitempty"`
+
+	// NextCursor is set when there are more logs to fetch with GetMoodLogsPage
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // DashboardResponse represents the dashboard data
@@ -107,9 +229,368 @@ type DashboardResponse struct {
 	Error          string      `json:"error,omitempty"`
 }
 
+// AnalyticsResponse represents the user's aggregated analytics
+type AnalyticsResponse struct {
+	TotalSessions int            `json:"total_sessions"`
+	CurrentStreak int            `json:"current_streak"`
+	BestStreak    int            `json:"best_streak"`
+	MoodTrends    map[string]int `json:"mood_trends,omitempty"`
+	WeeklyStats   map[string]int `json:"weekly_stats,omitempty"`
+	MonthlyStats  map[string]int `json:"monthly_stats,omitempty"`
+}
+
+// SetToken sets the bearer token sent instead of the bare user ID
+func (c *APIClient) SetToken(token string) {
+	c.token = token
+}
+
+// authorization returns the Authorization header value for requests
+func (c *APIClient) authorization() string {
+	if c.token != "" {
+		return "Bearer " + c.token
+	}
+	return c.userID
+}
+
+// Suggestion represents an AI recommendation of what to focus on next
+type Suggestion struct {
+	TaskID           string `json:"task_id,omitempty"`
+	TaskTitle        string `json:"task_title,omitempty"`
+	Action           string `json:"action"`
+	Reasoning        string `json:"reasoning,omitempty"`
+	SuggestedMinutes int    `json:"suggested_minutes,omitempty"`
+}
+
+// SuggestionRequest carries the context the AI uses to pick the next task
+type SuggestionRequest struct {
+	Mood         string  `json:"mood,omitempty"`
+	Intensity    int     `json:"intensity,omitempty"`
+	PendingTasks []*Task `json:"pending_tasks,omitempty"`
+}
+
+// SuggestionResponse represents the response from the AI suggestion endpoint
+type SuggestionResponse struct {
+	Success    bool        `json:"success"`
+	Suggestion *Suggestion `json:"suggestion,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// Session represents a focus session
+type Session struct {
+	ID              string `json:"id,omitempty"`
+	TaskID          string `json:"task_id"`
+	DurationMinutes int    `json:"duration_minutes"`
+	ActualMinutes   int    `json:"actual_minutes,omitempty"`
+	Pauses          int    `json:"pauses,omitempty"`
+	Aborted         bool   `json:"aborted,omitempty"`
+	FocusScore      int    `json:"focus_score,omitempty"`
+	StartedAt       string `json:"started_at,omitempty"`
+	CompletedAt     string `json:"completed_at,omitempty"`
+	IsCompleted     bool   `json:"is_completed,omitempty"`
+	TokensEarned    int    `json:"tokens_earned,omitempty"`
+	Distractions    int    `json:"distractions,omitempty"`
+	Energy          string `json:"energy_level,omitempty"`
+
+	NewAchievements []Achievement `json:"new_achievements,omitempty"`
+}
+
+// SessionEndRequest carries the outcome of a focus session when it ends
+type SessionEndRequest struct {
+	ActualMinutes int    `json:"actual_minutes"`
+	Pauses        int    `json:"pauses"`
+	Aborted       bool   `json:"aborted"`
+	FocusScore    int    `json:"focus_score"`
+	Distractions  int    `json:"distractions"`
+	Goal          string `json:"goal,omitempty"`
+	GoalAchieved  *bool  `json:"goal_achieved,omitempty"` // nil when there was no goal or no answer
+}
+
+// SessionStartRequest represents a focus session start request
+type SessionStartRequest struct {
+	TaskID          string `json:"task_id,omitempty"`
+	DurationMinutes int    `json:"duration_minutes"`
+	Goal            string `json:"goal,omitempty"`
+	Energy          string `json:"energy_level,omitempty"` // low, medium or high
+}
+
+// SessionResponse represents the response from session operations
+type SessionResponse struct {
+	Success  bool       `json:"success"`
+	Session  *Session   `json:"session,omitempty"`
+	Sessions []*Session `json:"sessions,omitempty"`
+	Error    string     `json:"error,omitempty"`
+	Message  string     `json:"message,omitempty"`
+
+	// NextCursor is set when there are more sessions to fetch with
+	// GetSessionHistoryPage
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// TaskNote is a timestamped note appended to a task
+type TaskNote struct {
+	ID        string `json:"id,omitempty"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// TaskNoteResponse represents the response from task note operations
+type TaskNoteResponse struct {
+	Success bool        `json:"success"`
+	Note    *TaskNote   `json:"note,omitempty"`
+	Notes   []*TaskNote `json:"notes,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+// Commitment is a user's pledge to complete a number of focus sessions on a
+// given day. The backend advances CompletedSessions as sessions finish.
+type Commitment struct {
+	Date              string `json:"date"`
+	TargetSessions    int    `json:"target_sessions"`
+	CompletedSessions int    `json:"completed_sessions"`
+}
+
+// Met reports whether the day's commitment has been fulfilled
+func (m *Commitment) Met() bool {
+	return m.CompletedSessions >= m.TargetSessions
+}
+
+// CommitmentRequest sets the number of sessions committed to for a day
+type CommitmentRequest struct {
+	Date           string `json:"date"`
+	TargetSessions int    `json:"target_sessions"`
+}
+
+// CommitmentResponse represents the response from commitment operations
+type CommitmentResponse struct {
+	Success    bool        `json:"success"`
+	Commitment *Commitment `json:"commitment,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	Message    string      `json:"message,omitempty"`
+}
+
+// LifetimeStats summarizes everything the user has done since signing up
+type LifetimeStats struct {
+	TotalFocusMinutes int    `json:"total_focus_minutes"`
+	TasksCompleted    int    `json:"tasks_completed"`
+	SessionsCompleted int    `json:"sessions_completed"`
+	LongestStreak     int    `json:"longest_streak"`
+	MoodsLogged       int    `json:"moods_logged"`
+	JoinedAt          string `json:"joined_at,omitempty"`
+}
+
+// LifetimeStatsResponse represents the response from the lifetime stats call
+type LifetimeStatsResponse struct {
+	Success bool           `json:"success"`
+	Stats   *LifetimeStats `json:"stats,omitempty"`
+	Error   string         `json:"error,omitempty"`
+	Message string         `json:"message,omitempty"`
+}
+
+// VacationRequest turns server-side streak tracking off or back on
+type VacationRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// VacationResponse represents the response from a vacation mode change
+type VacationResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Recurrence is a template the backend uses to create a task on a schedule.
+// Frequency is "daily", "weekdays", "weekly" or "monthly"; Interval repeats
+// it every N periods.
+type Recurrence struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	Frequency  string   `json:"frequency"`
+	Interval   int      `json:"interval,omitempty"`
+	Weekdays   []string `json:"weekdays,omitempty"`
+	DayOfMonth int      `json:"day_of_month,omitempty"`
+	NextRun    string   `json:"next_run,omitempty"`
+	Paused     bool     `json:"paused,omitempty"`
+}
+
+// RecurrenceResponse represents the response from recurrence operations
+type RecurrenceResponse struct {
+	Success     bool          `json:"success"`
+	Recurrence  *Recurrence   `json:"recurrence,omitempty"`
+	Recurrences []*Recurrence `json:"recurrences,omitempty"`
+	Error       string        `json:"error,omitempty"`
+	Message     string        `json:"message,omitempty"`
+}
+
+// apiResponse is implemented by responses that can carry a backend error
+type apiResponse interface {
+	errorText() string
+}
+
+func (r *TaskResponse) errorText() string          { return r.Error }
+func (r *MoodResponse) errorText() string          { return r.Error }
+func (r *DashboardResponse) errorText() string     { return r.Error }
+func (r *SuggestionResponse) errorText() string    { return r.Error }
+func (r *SessionResponse) errorText() string       { return r.Error }
+func (r *FeaturesResponse) errorText() string      { return r.Error }
+func (r *CommitmentResponse) errorText() string    { return r.Error }
+func (r *TaskNoteResponse) errorText() string      { return r.Error }
+func (r *RecurrenceResponse) errorText() string    { return r.Error }
+func (r *VacationResponse) errorText() string      { return r.Error }
+func (r *LifetimeStatsResponse) errorText() string { return r.Error }
+
+// FeaturesResponse lists the optional features the backend supports
+type FeaturesResponse struct {
+	Success  bool            `json:"success"`
+	Features map[string]bool `json:"features,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Message  string          `json:"message,omitempty"`
+}
+
+// newRequest builds a request with the standard headers, encoding body as
+// JSON when it is non-nil
+func (c *APIClient) newRequest(method, url string, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %v", err)
+		}
+		reader = bytes.NewBuffer(jsonData)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	// Set headers
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", c.authorization())
+
+	return req, nil
+}
+
+// cancelOnClose releases a request's context once its body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// send performs req, giving up with ErrTimeout if the whole exchange takes
+// longer than timeout. Network and server errors are retried as the retry
+// policy allows when req is safe to repeat. The caller must close the
+// response body.
+func (c *APIClient) send(req *http.Request, timeout time.Duration) (*http.Response, error) {
+	c.mu.Lock()
+	policy := c.retry
+	c.mu.Unlock()
+
+	retries := 0
+	if safeToRetry(req) {
+		retries = policy.Attempts
+	}
+
+	for retry := 1; ; retry++ {
+		resp, err := c.sendOnce(req, timeout)
+		if retry > retries {
+			return resp, err
+		}
+		if err != nil && !errors.Is(err, ErrNetwork) {
+			return resp, err
+		}
+		if err == nil && !retryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		delay := policy.delay(retry)
+		appLog.Info("retrying request", "method", req.Method, "path", req.URL.Path, "retry", retry, "delay", delay)
+		select {
+		case <-req.Context().Done():
+			return nil, networkError(req.Context().Err())
+		case <-time.After(delay):
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to resend request: %v", err)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// sendOnce makes a single attempt at req
+func (c *APIClient) sendOnce(req *http.Request, timeout time.Duration) (*http.Response, error) {
+	// Time spent waiting for the rate limiter doesn't count towards timeout
+	c.mu.Lock()
+	limiter := c.limiter
+	c.mu.Unlock()
+	if limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, networkError(err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	start := time.Now()
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = timeoutError(timeout)
+		} else {
+			err = networkError(err)
+		}
+		appLog.Error("request failed", "method", req.Method, "path", req.URL.Path, "error", err)
+		return nil, err
+	}
+	level := slog.LevelDebug
+	if resp.StatusCode >= 400 {
+		level = slog.LevelWarn
+	}
+	appLog.Log(context.Background(), level, "request", "method", req.Method, "path", req.URL.Path,
+		"status", resp.StatusCode, "elapsed", time.Since(start).Round(time.Millisecond))
+	resp.Body = cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// do sends req within timeout and decodes the JSON response into out
+func (c *APIClient) do(timeout time.Duration, req *http.Request, out interface{}) error {
+	resp, err := c.send(req, timeout)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := responseError(resp); err != nil {
+		return err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return timeoutError(timeout)
+		}
+		return decodeError(err)
+	}
+
+	return nil
+}
+
 // CreateTask creates a new task
 func (c *APIClient) CreateTask(taskReq TaskCreateRequest) (*TaskResponse, error) {
-	url := fmt.Sprintf("%s/api/v1/tasks/", c.baseURL)
+	url := fmt.Sprintf("%s/api/v1/tasks/", c.baseFor(resourceTasks))
 	
 	// Add user_id to request
 	requestData := map[string]interface{}{
@@ -119,6 +600,18 @@ func (c *APIClient) CreateTask(taskReq TaskCreateRequest) (*TaskResponse, error)
 		"category":         taskReq.Category,
 		"priority":         taskReq.Priority,
 	}
+	if len(taskReq.DependsOn) > 0 {
+		requestData["depends_on"] = taskReq.DependsOn
+	}
+	if taskReq.ColorLabel != "" {
+		requestData["color_label"] = taskReq.ColorLabel
+	}
+	if taskReq.EffortPoints != 0 {
+		if err := validateEffort(taskReq.EffortPoints); err != nil {
+			return nil, err
+		}
+		requestData["effort_points"] = taskReq.EffortPoints
+	}
 	
 	jsonData, err := json.Marshal(requestData)
 	if err != nil {
@@ -132,25 +625,39 @@ func (c *APIClient) CreateTask(taskReq TaskCreateRequest) (*TaskResponse, error)
 	
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.userID)
+	req.Header.Set("Authorization", c.authorization())
+	if taskReq.IdempotencyKey != "" {
+		req.Header.Set(idempotencyHeader, taskReq.IdempotencyKey)
+	}
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req, c.timeout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 	
+	if err := responseError(resp); err != nil {
+		return nil, err
+	}
+	
 	var taskResp TaskResponse
 	if err := json.NewDecoder(resp.Body).Decode(&taskResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+		return nil, decodeError(err)
 	}
 	
 	return &taskResp, nil
 }
 
-// GetTasks retrieves tasks for the user
+// GetTasks retrieves tasks for the user. status may list several statuses
+// separated by commas, e.g. "pending,in_progress".
 func (c *APIClient) GetTasks(status, category string, limit int) (*TaskResponse, error) {
-	url := fmt.Sprintf("%s/api/v1/tasks/", c.baseURL)
+	return c.GetTasksPage(status, category, limit, "")
+}
+
+// GetTasksPage retrieves the page of tasks starting at cursor, the
+// NextCursor of the previous page; an empty cursor fetches the first page
+func (c *APIClient) GetTasksPage(status, category string, limit int, cursor string) (*TaskResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/", c.baseFor(resourceTasks))
 	
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -158,7 +665,7 @@ func (c *APIClient) GetTasks(status, category string, limit int) (*TaskResponse,
 	}
 	
 	// Set headers
-	req.Header.Set("Authorization", c.userID)
+	req.Header.Set("Authorization", c.authorization())
 	
 	// Add query parameters
 	q := req.URL.Query()
@@ -171,17 +678,24 @@ func (c *APIClient) GetTasks(status, category string, limit int) (*TaskResponse,
 	if limit > 0 {
 		q.Add("limit", fmt.Sprintf("%d", limit))
 	}
+	if cursor != "" {
+		q.Add("cursor", cursor)
+	}
 	req.URL.RawQuery = q.Encode()
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req, c.timeout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 	
+	if err := responseError(resp); err != nil {
+		return nil, err
+	}
+	
 	var taskResp TaskResponse
 	if err := json.NewDecoder(resp.Body).Decode(&taskResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+		return nil, decodeError(err)
 	}
 	
 	return &taskResp, nil
@@ -189,7 +703,7 @@ func (c *APIClient) GetTasks(status, category string, limit int) (*TaskResponse,
 
 // GetDashboard retrieves the user dashboard
 func (c *APIClient) GetDashboard() (*DashboardResponse, error) {
-	url := fmt.Sprintf("%s/api/v1/tasks/dashboard", c.baseURL)
+	url := fmt.Sprintf("%s/api/v1/tasks/dashboard", c.baseFor(resourceTasks))
 	
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -197,17 +711,21 @@ func (c *APIClient) GetDashboard() (*DashboardResponse, error) {
 	}
 	
 	// Set headers
-	req.Header.Set("Authorization", c.userID)
+	req.Header.Set("Authorization", c.authorization())
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req, c.timeout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 	
+	if err := responseError(resp); err != nil {
+		return nil, err
+	}
+	
 	var dashboardResp DashboardResponse
 	if err := json.NewDecoder(resp.Body).Decode(&dashboardResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+		return nil, decodeError(err)
 	}
 	
 	return &dashboardResp, nil
@@ -215,7 +733,7 @@ func (c *APIClient) GetDashboard() (*DashboardResponse, error) {
 
 // LogMood logs a mood entry
 func (c *APIClient) LogMood(moodReq MoodLogRequest) (*MoodResponse, error) {
-	url := fmt.Sprintf("%s/api/v1/mood/", c.baseURL)
+	url := fmt.Sprintf("%s/api/v1/mood/", c.baseFor(resourceMood))
 	
 	jsonData, err := json.Marshal(moodReq)
 	if err != nil {
@@ -229,17 +747,24 @@ func (c *APIClient) LogMood(moodReq MoodLogRequest) (*MoodResponse, error) {
 	
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.userID)
+	req.Header.Set("Authorization", c.authorization())
+	if moodReq.IdempotencyKey != "" {
+		req.Header.Set(idempotencyHeader, moodReq.IdempotencyKey)
+	}
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req, c.timeout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 	
+	if err := responseError(resp); err != nil {
+		return nil, err
+	}
+	
 	var moodResp MoodResponse
 	if err := json.NewDecoder(resp.Body).Decode(&moodResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+		return nil, decodeError(err)
 	}
 	
 	return &moodResp, nil
@@ -247,7 +772,13 @@ func (c *APIClient) LogMood(moodReq MoodLogRequest) (*MoodResponse, error) {
 
 // GetMoodLogs retrieves mood logs for the user
 func (c *APIClient) GetMoodLogs(limit int) (*MoodResponse, error) {
-	url := fmt.Sprintf("%s/api/v1/mood/", c.baseURL)
+	return c.GetMoodLogsPage(limit, "")
+}
+
+// GetMoodLogsPage retrieves the page of mood logs starting at cursor, the
+// NextCursor of the previous page; an empty cursor fetches the first page
+func (c *APIClient) GetMoodLogsPage(limit int, cursor string) (*MoodResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/mood/", c.baseFor(resourceMood))
 	
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -255,36 +786,88 @@ func (c *APIClient) GetMoodLogs(limit int) (*MoodResponse, error) {
 	}
 	
 	// Set headers
-	req.Header.Set("Authorization", c.userID)
+	req.Header.Set("Authorization", c.authorization())
 	
 	// Add query parameters
 	q := req.URL.Query()
 	if limit > 0 {
 		q.Add("limit", fmt.Sprintf("%d", limit))
 	}
+	if cursor != "" {
+		q.Add("cursor", cursor)
+	}
 	req.URL.RawQuery = q.Encode()
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req, c.timeout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 	
+	if err := responseError(resp); err != nil {
+		return nil, err
+	}
+	
 	var moodResp MoodResponse
 	if err := json.NewDecoder(resp.Body).Decode(&moodResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+		return nil, decodeError(err)
 	}
 	
 	return &moodResp, nil
 }
 
+// GetMoodLogsRange retrieves the mood logs recorded from from up to, but not
+// including, to
+func (c *APIClient) GetMoodLogsRange(from, to time.Time) (*MoodResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/mood/", c.baseFor(resourceMood))
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("from", from.UTC().Format(time.RFC3339))
+	q.Add("to", to.UTC().Format(time.RFC3339))
+	req.URL.RawQuery = q.Encode()
+
+	var moodResp MoodResponse
+	if err := c.do(c.timeout, req, &moodResp); err != nil {
+		return nil, err
+	}
+
+	return &moodResp, nil
+}
+
+// DeleteMoodLog removes a mood log entry
+func (c *APIClient) DeleteMoodLog(id string) (*MoodResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/mood/%s", c.baseFor(resourceMood), id)
+
+	req, err := c.newRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var moodResp MoodResponse
+	if err := c.do(c.timeout, req, &moodResp); err != nil {
+		return nil, err
+	}
+
+	return &moodResp, nil
+}
+
 // HealthCheck checks if the API is accessible
 func (c *APIClient) HealthCheck() error {
 	url := fmt.Sprintf("%s/health", c.baseURL)
 	
-	resp, err := c.httpClient.Get(url)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return fmt.Errorf("health check failed: %v", err)
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	
+	resp, err := c.send(req, c.timeout)
+	if err != nil {
+		return err
 	}
 	defer resp.Body.Close()
 	
@@ -294,3 +877,486 @@ func (c *APIClient) HealthCheck() error {
 	
 	return nil
 }
+
+// SetCommitment commits to completing count focus sessions on date
+// (YYYY-MM-DD)
+func (c *APIClient) SetCommitment(date string, count int) (*CommitmentResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/commitments", c.baseFor(resourceGamification))
+
+	req, err := c.newRequest("POST", url, CommitmentRequest{
+		Date:           date,
+		TargetSessions: count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var commitmentResp CommitmentResponse
+	if err := c.do(c.timeout, req, &commitmentResp); err != nil {
+		return nil, err
+	}
+
+	return &commitmentResp, nil
+}
+
+// SetVacationMode tells the backend the user is away, so streaks are paused
+// rather than broken
+func (c *APIClient) SetVacationMode(on bool) (*VacationResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/vacation", c.baseFor(resourceGamification))
+
+	req, err := c.newRequest("PUT", url, VacationRequest{Enabled: on})
+	if err != nil {
+		return nil, err
+	}
+
+	var vacationResp VacationResponse
+	if err := c.do(c.timeout, req, &vacationResp); err != nil {
+		return nil, err
+	}
+
+	return &vacationResp, nil
+}
+
+// GetCommitment retrieves the commitment for a day (YYYY-MM-DD). The
+// response has no commitment if none was made that day.
+func (c *APIClient) GetCommitment(date string) (*CommitmentResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/commitments", c.baseFor(resourceGamification))
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("date", date)
+	req.URL.RawQuery = q.Encode()
+
+	var commitmentResp CommitmentResponse
+	if err := c.do(c.timeout, req, &commitmentResp); err != nil {
+		return nil, err
+	}
+
+	return &commitmentResp, nil
+}
+
+// GetFeatures retrieves the backend's feature flags
+func (c *APIClient) GetFeatures() (*FeaturesResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/features", c.baseURL)
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var featuresResp FeaturesResponse
+	if err := c.do(c.timeout, req, &featuresResp); err != nil {
+		return nil, err
+	}
+
+	return &featuresResp, nil
+}
+
+// GetNextSuggestion asks the AI what the user should focus on next, passing
+// the latest mood and pending tasks as context
+func (c *APIClient) GetNextSuggestion() (*SuggestionResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/ai/suggest", c.baseURL)
+
+	// Context is best-effort: the AI can still suggest without it
+	suggestionReq := SuggestionRequest{}
+	if moodResp, err := c.GetMoodLogs(1); err == nil && len(moodResp.MoodLogs) > 0 {
+		suggestionReq.Mood = moodResp.MoodLogs[0].Feeling
+		suggestionReq.Intensity = moodResp.MoodLogs[0].Intensity
+	}
+	if taskResp, err := c.GetTasks("pending", "", 50); err == nil {
+		suggestionReq.PendingTasks = taskResp.Tasks
+	}
+
+	req, err := c.newRequest("POST", url, suggestionReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var suggestionResp SuggestionResponse
+	if err := c.do(c.aiTimeout, req, &suggestionResp); err != nil {
+		return nil, err
+	}
+
+	return &suggestionResp, nil
+}
+
+// StartSession starts a focus session on a task
+func (c *APIClient) StartSession(sessionReq SessionStartRequest) (*SessionResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/pomodoro/start", c.baseFor(resourceSessions))
+
+	req, err := c.newRequest("POST", url, sessionReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionResp SessionResponse
+	if err := c.do(c.timeout, req, &sessionResp); err != nil {
+		return nil, err
+	}
+
+	return &sessionResp, nil
+}
+
+// sessionTaskRequest is the body of a session task assignment call
+type sessionTaskRequest struct {
+	TaskID string `json:"task_id"`
+}
+
+// AssignSessionTask attaches a task to a session started without one
+func (c *APIClient) AssignSessionTask(sessionID, taskID string) (*SessionResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/pomodoro/%s/task", c.baseFor(resourceSessions), sessionID)
+
+	req, err := c.newRequest("PUT", url, sessionTaskRequest{TaskID: taskID})
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionResp SessionResponse
+	if err := c.do(c.timeout, req, &sessionResp); err != nil {
+		return nil, err
+	}
+
+	return &sessionResp, nil
+}
+
+// EndSession marks a focus session as complete, recording its outcome
+func (c *APIClient) EndSession(sessionID string, endReq SessionEndRequest) (*SessionResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/pomodoro/%s/complete", c.baseFor(resourceSessions), sessionID)
+
+	req, err := c.newRequest("PUT", url, endReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionResp SessionResponse
+	if err := c.do(c.timeout, req, &sessionResp); err != nil {
+		return nil, err
+	}
+
+	return &sessionResp, nil
+}
+
+// GetTask retrieves a single task including its blocks
+func (c *APIClient) GetTask(taskID string) (*TaskResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s?include_blocks=true", c.baseFor(resourceTasks), taskID)
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskResp TaskResponse
+	if err := c.do(c.timeout, req, &taskResp); err != nil {
+		return nil, err
+	}
+
+	return &taskResp, nil
+}
+
+// UpdateTask applies a partial update to a task
+func (c *APIClient) UpdateTask(taskID string, update TaskUpdateRequest) (*TaskResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s", c.baseFor(resourceTasks), taskID)
+
+	req, err := c.newRequest("PUT", url, update)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskResp TaskResponse
+	if err := c.do(c.timeout, req, &taskResp); err != nil {
+		return nil, err
+	}
+
+	return &taskResp, nil
+}
+
+// blockOrderRequest is the body of a block reorder call
+type blockOrderRequest struct {
+	Order []string `json:"order"`
+}
+
+// ReorderBlocks sets the order of a task's blocks. order must list every
+// block ID of the task exactly once.
+func (c *APIClient) ReorderBlocks(taskID string, order []string) (*TaskResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s/blocks/reorder", c.baseFor(resourceTasks), taskID)
+
+	req, err := c.newRequest("PUT", url, blockOrderRequest{Order: order})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskResp TaskResponse
+	if err := c.do(c.timeout, req, &taskResp); err != nil {
+		return nil, err
+	}
+
+	return &taskResp, nil
+}
+
+// blockUpdateRequest is the body of a block update call
+type blockUpdateRequest struct {
+	DurationMinutes int `json:"duration_minutes"`
+}
+
+// UpdateBlock changes how long one block of a task is planned to take
+func (c *APIClient) UpdateBlock(taskID, blockID string, durationMinutes int) (*TaskResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s/blocks/%s", c.baseFor(resourceTasks), taskID, blockID)
+
+	req, err := c.newRequest("PUT", url, blockUpdateRequest{DurationMinutes: durationMinutes})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskResp TaskResponse
+	if err := c.do(c.timeout, req, &taskResp); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("block %s does not exist on task %s", blockID, taskID)
+		}
+		return nil, err
+	}
+
+	return &taskResp, nil
+}
+
+// AddTaskNote appends a note to a task
+func (c *APIClient) AddTaskNote(taskID, note string) (*TaskNoteResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s/notes", c.baseFor(resourceTasks), taskID)
+
+	req, err := c.newRequest("POST", url, TaskNote{Content: note})
+	if err != nil {
+		return nil, err
+	}
+
+	var noteResp TaskNoteResponse
+	if err := c.do(c.timeout, req, &noteResp); err != nil {
+		return nil, err
+	}
+
+	return &noteResp, nil
+}
+
+// GetTaskNotes retrieves the notes added to a task
+func (c *APIClient) GetTaskNotes(taskID string) (*TaskNoteResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s/notes", c.baseFor(resourceTasks), taskID)
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var noteResp TaskNoteResponse
+	if err := c.do(c.timeout, req, &noteResp); err != nil {
+		return nil, err
+	}
+
+	return &noteResp, nil
+}
+
+// snoozeRequest is the body of a task snooze call
+type snoozeRequest struct {
+	Until string `json:"until"`
+}
+
+// SnoozeTask hides a task from the default task list until the given time
+func (c *APIClient) SnoozeTask(taskID string, until time.Time) (*TaskResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s/snooze", c.baseFor(resourceTasks), taskID)
+
+	req, err := c.newRequest("POST", url, snoozeRequest{Until: until.UTC().Format(time.RFC3339)})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskResp TaskResponse
+	if err := c.do(c.timeout, req, &taskResp); err != nil {
+		return nil, err
+	}
+
+	return &taskResp, nil
+}
+
+// CompleteTask marks a task as complete, recording a 1-5 rating of how it
+// went. A rating of 0 leaves the task unrated.
+func (c *APIClient) CompleteTask(taskID string, rating int) (*TaskResponse, error) {
+	return c.UpdateTask(taskID, TaskUpdateRequest{Status: "completed", Rating: rating})
+}
+
+// CompleteBlock marks a single block of a task as complete
+func (c *APIClient) CompleteBlock(taskID, blockID string) (*TaskResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/tasks/%s/blocks/%s/complete", c.baseFor(resourceTasks), taskID, blockID)
+
+	req, err := c.newRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskResp TaskResponse
+	if err := c.do(c.timeout, req, &taskResp); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("block %s does not exist on task %s", blockID, taskID)
+		}
+		return nil, err
+	}
+
+	return &taskResp, nil
+}
+
+// GetAnalytics retrieves the user's aggregated analytics
+func (c *APIClient) GetAnalytics() (*AnalyticsResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/analytics/", c.baseFor(resourceGamification))
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var analyticsResp AnalyticsResponse
+	if err := c.do(c.timeout, req, &analyticsResp); err != nil {
+		return nil, err
+	}
+
+	return &analyticsResp, nil
+}
+
+// GetLifetimeStats retrieves the user's all-time totals
+func (c *APIClient) GetLifetimeStats() (*LifetimeStatsResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/analytics/lifetime", c.baseFor(resourceGamification))
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var statsResp LifetimeStatsResponse
+	if err := c.do(c.timeout, req, &statsResp); err != nil {
+		return nil, err
+	}
+
+	return &statsResp, nil
+}
+
+// GetSessionHistory retrieves the user's past focus sessions, newest first
+func (c *APIClient) GetSessionHistory(limit int) (*SessionResponse, error) {
+	return c.GetSessionHistoryPage(limit, "")
+}
+
+// GetSessionHistoryPage retrieves the page of past sessions starting at
+// cursor, the NextCursor of the previous page; an empty cursor fetches the
+// first page
+func (c *APIClient) GetSessionHistoryPage(limit int, cursor string) (*SessionResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/pomodoro/", c.baseFor(resourceSessions))
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	if limit > 0 {
+		q.Add("limit", fmt.Sprintf("%d", limit))
+	}
+	if cursor != "" {
+		q.Add("cursor", cursor)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	var sessionResp SessionResponse
+	if err := c.do(c.timeout, req, &sessionResp); err != nil {
+		return nil, err
+	}
+
+	return &sessionResp, nil
+}
+
+// GetTaskSessions retrieves the focus sessions logged against a task
+func (c *APIClient) GetTaskSessions(taskID string) (*SessionResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/pomodoro/", c.baseFor(resourceSessions))
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("task_id", taskID)
+	req.URL.RawQuery = q.Encode()
+
+	var sessionResp SessionResponse
+	if err := c.do(c.timeout, req, &sessionResp); err != nil {
+		return nil, err
+	}
+
+	return &sessionResp, nil
+}
+
+// UpdateSessionProgress reports how far into a running session the user is,
+// so other devices can show the live session
+func (c *APIClient) UpdateSessionProgress(sessionID string, elapsedSeconds int) (*SessionResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/sessions/%s/progress", c.baseFor(resourceSessions), sessionID)
+
+	req, err := c.newRequest("POST", url, map[string]int{"elapsed_seconds": elapsedSeconds})
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionResp SessionResponse
+	if err := c.do(c.timeout, req, &sessionResp); err != nil {
+		return nil, err
+	}
+
+	return &sessionResp, nil
+}
+
+// GetRecurrences lists the user's recurring task templates
+func (c *APIClient) GetRecurrences() (*RecurrenceResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/recurrences", c.baseFor(resourceTasks))
+
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var recurrenceResp RecurrenceResponse
+	if err := c.do(c.timeout, req, &recurrenceResp); err != nil {
+		return nil, err
+	}
+
+	return &recurrenceResp, nil
+}
+
+// PauseRecurrence stops or restarts a recurrence creating tasks
+func (c *APIClient) PauseRecurrence(id string, paused bool) (*RecurrenceResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/recurrences/%s", c.baseFor(resourceTasks), id)
+
+	req, err := c.newRequest("PATCH", url, map[string]bool{"paused": paused})
+	if err != nil {
+		return nil, err
+	}
+
+	var recurrenceResp RecurrenceResponse
+	if err := c.do(c.timeout, req, &recurrenceResp); err != nil {
+		return nil, err
+	}
+
+	return &recurrenceResp, nil
+}
+
+// DeleteRecurrence removes a recurrence; tasks it already created are kept
+func (c *APIClient) DeleteRecurrence(id string) (*RecurrenceResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/recurrences/%s", c.baseFor(resourceTasks), id)
+
+	req, err := c.newRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var recurrenceResp RecurrenceResponse
+	if err := c.do(c.timeout, req, &recurrenceResp); err != nil {
+		return nil, err
+	}
+
+	return &recurrenceResp, nil
+}
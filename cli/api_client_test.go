@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitThrottlesRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// A limit of n per second lets a burst of n through at once, so the
+	// n+1th request has to wait a full 1/n seconds
+	const n = 1
+	client := NewAPIClient(srv.URL, "tester")
+	client.SetRateLimit(n)
+
+	start := time.Now()
+	for i := 0; i < n+1; i++ {
+		if err := client.HealthCheck(); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("%d requests at %d/s took %s, want about 1s", n+1, n, elapsed)
+	}
+}
+
+func TestRateLimitZeroDoesNotThrottle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := NewAPIClient(srv.URL, "tester")
+	client.SetRateLimit(0)
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		if err := client.HealthCheck(); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("20 unthrottled requests took %s", elapsed)
+	}
+}
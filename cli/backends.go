@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// backendCheckTimeout bounds each backend's health check
+const backendCheckTimeout = 5 * time.Second
+
+// backendStatus is the health check result for one backend
+type backendStatus struct {
+	Name    string
+	URL     string
+	Err     error
+	Latency time.Duration
+}
+
+// backendTargets returns the backends to check: the one in use followed by
+// the configured profiles in name order
+func (c *FocusForgeCLI) backendTargets() []backendStatus {
+	targets := []backendStatus{{Name: "current", URL: c.apiURL}}
+
+	names := make([]string, 0, len(c.config.Profiles))
+	for name := range c.config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		targets = append(targets, backendStatus{Name: name, URL: c.config.Profiles[name]})
+	}
+	return targets
+}
+
+// checkBackends health checks every target concurrently
+func (c *FocusForgeCLI) checkBackends(targets []backendStatus) {
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+		go func(target *backendStatus) {
+			defer wg.Done()
+			client := NewAPIClient(target.URL, c.userID)
+			client.SetTimeout(backendCheckTimeout)
+			start := time.Now()
+			target.Err = client.HealthCheck()
+			target.Latency = time.Since(start)
+		}(&targets[i])
+	}
+	wg.Wait()
+}
+
+func (c *FocusForgeCLI) showBackendHealth() {
+	for {
+		color.Cyan("🩺 Check All Backends")
+		fmt.Println()
+
+		targets := c.backendTargets()
+		stop := startSpinner(fmt.Sprintf("Checking %d backends", len(targets)))
+		c.checkBackends(targets)
+		stop()
+
+		if isNarrow() {
+			// One backend per block instead of a table that would wrap
+			for _, t := range targets {
+				status := color.GreenString("● up")
+				if t.Err != nil {
+					status = color.RedString("● down")
+				}
+				fmt.Printf("%s  %s  %d ms\n", t.Name, status, t.Latency.Milliseconds())
+				dimmed.Printf("  %s\n", fitWidth(t.URL, terminalWidth()-2))
+			}
+		} else {
+			printBackendTable(targets)
+		}
+		for _, t := range targets {
+			if t.Err != nil {
+				dimmed.Printf("  %s: %v\n", t.Name, t.Err)
+			}
+		}
+		fmt.Println()
+
+		prompt := promptui.Select{
+			Label: "What would you like to do?",
+			Items: []string{"🔄 Check Again", "➕ Add Backend", "🗑️  Remove Backend", "🔙 Back"},
+		}
+		_, choice, err := prompt.Run()
+		if err != nil {
+			return
+		}
+
+		switch choice {
+		case "➕ Add Backend":
+			c.addBackendProfile()
+		case "🗑️  Remove Backend":
+			c.removeBackendProfile()
+		case "🔙 Back":
+			return
+		}
+	}
+}
+
+// addBackendProfile saves a named backend URL to check alongside the
+// current one
+func (c *FocusForgeCLI) addBackendProfile() {
+	namePrompt := promptui.Prompt{
+		Label: "Name (e.g. staging)",
+		Validate: func(input string) error {
+			name := strings.TrimSpace(input)
+			if name == "" || name == "current" {
+				return fmt.Errorf("enter a name other than \"current\"")
+			}
+			return nil
+		},
+	}
+	name, err := namePrompt.Run()
+	if err != nil {
+		return
+	}
+
+	urlPrompt := promptui.Prompt{
+		Label: "API URL",
+		Validate: func(input string) error {
+			u, err := url.Parse(strings.TrimSpace(input))
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("enter a URL like https://staging.example.com")
+			}
+			return nil
+		},
+	}
+	apiURL, err := urlPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	if c.config.Profiles == nil {
+		c.config.Profiles = map[string]string{}
+	}
+	c.config.Profiles[strings.TrimSpace(name)] = strings.TrimRight(strings.TrimSpace(apiURL), "/")
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+}
+
+// removeBackendProfile deletes a saved backend
+func (c *FocusForgeCLI) removeBackendProfile() {
+	if len(c.config.Profiles) == 0 {
+		color.Yellow("No saved backends to remove.")
+		fmt.Println()
+		return
+	}
+
+	names := make([]string, 0, len(c.config.Profiles))
+	for name := range c.config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	prompt := promptui.Select{
+		Label: "Remove which backend?",
+		Items: names,
+	}
+	_, name, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	delete(c.config.Profiles, name)
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+}
+
+// printBackendTable shows the health check results as a table
+func printBackendTable(targets []backendStatus) {
+	nameWidth, urlWidth := len("Backend"), len("URL")
+	for _, t := range targets {
+		if len(t.Name) > nameWidth {
+			nameWidth = len(t.Name)
+		}
+		if len(t.URL) > urlWidth {
+			urlWidth = len(t.URL)
+		}
+	}
+
+	fmt.Printf("%-*s  %-*s  %-6s  %s\n", nameWidth, "Backend", urlWidth, "URL", "Status", "Latency")
+	fmt.Printf("%s  %s  %s  %s\n", strings.Repeat("-", nameWidth), strings.Repeat("-", urlWidth), "------", "-------")
+	for _, t := range targets {
+		status := color.GreenString("● up  ")
+		if t.Err != nil {
+			status = color.RedString("● down")
+		}
+		fmt.Printf("%-*s  %-*s  %s  %5d ms\n", nameWidth, t.Name, urlWidth, t.URL, status, t.Latency.Milliseconds())
+	}
+}
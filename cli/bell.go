@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// ringBell alerts the user that a timer needs attention. It plays the chosen
+// completion sound if there is one, falls back to the terminal bell if the
+// sound can't be played, and stays silent when the bell is turned off.
+func (c *FocusForgeCLI) ringBell() {
+	if !c.config.Bell {
+		return
+	}
+
+	if c.config.CompletionSound != "" {
+		err := playSound(c.config.CompletionSound, c.config.SoundVolume)
+		if err == nil {
+			return
+		}
+		appLog.Warn("completion sound failed", "sound", c.config.CompletionSound, "error", err)
+	}
+	fmt.Print("\a")
+}
+
+// playSound plays a sound from the sounds directory once, without waiting
+// for it to finish
+func playSound(name string, volume int) error {
+	path, err := soundscapePath(name)
+	if err != nil {
+		return err
+	}
+	cmd, err := playerCommand(path, volume)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to play sound: %v", err)
+	}
+	go cmd.Wait()
+	return nil
+}
+
+func (c *FocusForgeCLI) showBellSettings() {
+	color.Cyan("🔔 Bell & Sounds")
+	fmt.Println()
+
+	sound := c.config.CompletionSound
+	if sound == "" {
+		sound = "terminal bell"
+	}
+	fmt.Printf("Bell: %s\n", onOff(c.config.Bell))
+	fmt.Printf("Completion sound: %s\n", sound)
+	fmt.Println()
+
+	enablePrompt := promptui.Select{
+		Label: "Alert you when focus time is up?",
+		Items: []string{"Yes", "No - stay silent"},
+	}
+	idx, _, err := enablePrompt.Run()
+	if err != nil {
+		return
+	}
+	c.config.Bell = idx == 0
+
+	if c.config.Bell {
+		names := availableSoundscapes()
+		if len(names) == 0 {
+			dir, _ := soundsDir()
+			dimmed.Printf("To use a custom sound instead of the terminal bell, add a .wav or .mp3 file to %s\n", dir)
+		} else {
+			items := append([]string{"terminal bell"}, names...)
+			soundPrompt := promptui.Select{
+				Label:     "Sound to play",
+				Items:     items,
+				CursorPos: indexOf(items, sound),
+			}
+			if _, choice, err := soundPrompt.Run(); err == nil {
+				c.config.CompletionSound = ""
+				if choice != "terminal bell" {
+					c.config.CompletionSound = choice
+				}
+			}
+		}
+	}
+
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+}
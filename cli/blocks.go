@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// blockDriftPercent is how far, as a percentage of the task's duration, the
+// blocks' total may drift before it is worth a warning
+const blockDriftPercent = 20
+
+// blockMinutes returns the total planned duration of blocks
+func blockMinutes(blocks []*TaskBlock) int {
+	total := 0
+	for _, block := range blocks {
+		total += block.DurationMinutes
+	}
+	return total
+}
+
+// blocksDiverge reports whether the blocks' total differs from the task's
+// duration by more than blockDriftPercent
+func blocksDiverge(task *Task) bool {
+	if task.DurationMinutes <= 0 || len(task.Blocks) == 0 {
+		return false
+	}
+	diff := blockMinutes(task.Blocks) - task.DurationMinutes
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff*100 > task.DurationMinutes*blockDriftPercent
+}
+
+// printBlockTotals shows the blocks' total against the task's duration,
+// warning when they have drifted apart
+func printBlockTotals(task *Task) {
+	total := blockMinutes(task.Blocks)
+	fmt.Printf("  Blocks total %d min of the %d min planned\n", total, task.DurationMinutes)
+	if blocksDiverge(task) {
+		color.Yellow("⚠️  Blocks are %+d min off the task's duration - adjust a block or the task", total-task.DurationMinutes)
+	}
+}
+
+// editBlockDuration lets the user pick one of a task's blocks and change its
+// duration
+func (c *FocusForgeCLI) editBlockDuration(task *Task) {
+	items := make([]string, 0, len(task.Blocks)+1)
+	for i, block := range task.Blocks {
+		items = append(items, fmt.Sprintf("%d. %s (%d min)", i+1, block.Title, block.DurationMinutes))
+	}
+	items = append(items, "🔙 Back")
+
+	blockPrompt := promptui.Select{
+		Label: "Select a block to adjust",
+		Items: items,
+		Size:  10,
+	}
+	idx, _, err := blockPrompt.Run()
+	if err != nil || idx == len(task.Blocks) {
+		return
+	}
+	block := task.Blocks[idx]
+
+	durationPrompt := promptui.Prompt{
+		Label:    fmt.Sprintf("Minutes for \"%s\"", block.Title),
+		Default:  strconv.Itoa(block.DurationMinutes),
+		Validate: validateDuration,
+	}
+	input, err := durationPrompt.Run()
+	if err != nil {
+		return
+	}
+	minutes, _ := strconv.Atoi(strings.TrimSpace(input))
+	if minutes == block.DurationMinutes {
+		return
+	}
+
+	resp, err := c.apiClient.UpdateBlock(task.ID, block.ID, minutes)
+	if err != nil {
+		color.Red("❌ Failed to update block: %v", err)
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to update block: %s", errorMessage(resp))
+		return
+	}
+	color.Green("✓ \"%s\" now takes %d min (was %d)", block.Title, minutes, block.DurationMinutes)
+}
+
+// validateBlockOrder checks that order lists each of the task's block IDs
+// exactly once
+func validateBlockOrder(blocks []*TaskBlock, order []string) error {
+	if len(order) != len(blocks) {
+		return fmt.Errorf("order has %d blocks, task has %d", len(order), len(blocks))
+	}
+	remaining := make(map[string]bool, len(blocks))
+	for _, block := range blocks {
+		remaining[block.ID] = true
+	}
+	for _, id := range order {
+		if !remaining[id] {
+			return fmt.Errorf("block %s is unknown or listed twice", id)
+		}
+		delete(remaining, id)
+	}
+	return nil
+}
+
+// moveBlock returns a copy of blocks with the block at from moved to to
+func moveBlock(blocks []*TaskBlock, from, to int) []*TaskBlock {
+	moved := make([]*TaskBlock, 0, len(blocks))
+	for i, block := range blocks {
+		if i != from {
+			moved = append(moved, block)
+		}
+	}
+	moved = append(moved[:to], append([]*TaskBlock{blocks[from]}, moved[to:]...)...)
+	return moved
+}
+
+// reorderBlocks lets the user move a task's blocks up and down, then saves
+// the new order
+func (c *FocusForgeCLI) reorderBlocks(task *Task) {
+	const (
+		save   = "💾 Save Order"
+		cancel = "✗ Cancel"
+	)
+
+	blocks := append([]*TaskBlock(nil), task.Blocks...)
+	for {
+		items := make([]string, 0, len(blocks)+2)
+		for i, block := range blocks {
+			items = append(items, fmt.Sprintf("%d. %s (%d min)", i+1, block.Title, block.DurationMinutes))
+		}
+		items = append(items, save, cancel)
+
+		blockPrompt := promptui.Select{
+			Label: "Select a block to move",
+			Items: items,
+			Size:  10,
+		}
+		idx, choice, err := blockPrompt.Run()
+		if err != nil || choice == cancel {
+			return
+		}
+		if choice == save {
+			break
+		}
+
+		positions := make([]string, len(blocks))
+		for i := range blocks {
+			positions[i] = fmt.Sprintf("Position %d", i+1)
+		}
+		positionPrompt := promptui.Select{
+			Label:     fmt.Sprintf("Move \"%s\" to", blocks[idx].Title),
+			Items:     positions,
+			CursorPos: idx,
+			Size:      10,
+		}
+		to, _, err := positionPrompt.Run()
+		if err != nil {
+			continue
+		}
+		blocks = moveBlock(blocks, idx, to)
+	}
+
+	order := make([]string, len(blocks))
+	for i, block := range blocks {
+		order[i] = block.ID
+	}
+	if err := validateBlockOrder(task.Blocks, order); err != nil {
+		color.Red("❌ Invalid block order: %v", err)
+		return
+	}
+
+	resp, err := c.apiClient.ReorderBlocks(task.ID, order)
+	if err != nil {
+		color.Red("❌ Failed to reorder blocks: %v", err)
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to reorder blocks: %s", errorMessage(resp))
+		return
+	}
+	color.Green("✓ Block order saved")
+}
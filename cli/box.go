@@ -0,0 +1,86 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-runewidth"
+)
+
+// boxStyle is the set of characters a box border is drawn with
+type boxStyle struct {
+	TopLeft, TopRight, BottomLeft, BottomRight string
+	Horizontal, Vertical                       string
+}
+
+var (
+	// doubleBox is used for the welcome banner
+	doubleBox = boxStyle{"╔", "╗", "╚", "╝", "═", "║"}
+	// roundedBox is used for information panels
+	roundedBox = boxStyle{"╭", "╮", "╰", "╯", "─", "│"}
+)
+
+// ansiEscape matches the color codes in styled strings
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// displayWidth returns how many terminal columns s takes up, counting emoji
+// and other wide characters as two and ignoring color codes
+func displayWidth(s string) int {
+	return runewidth.StringWidth(ansiEscape.ReplaceAllString(s, ""))
+}
+
+// fitWidth shortens s to at most max display columns, ending it with an
+// ellipsis when anything was cut
+func fitWidth(s string, max int) string {
+	if max <= 0 || displayWidth(s) <= max {
+		return s
+	}
+	return runewidth.Truncate(s, max, "…")
+}
+
+// padRight pads s with spaces to width display columns
+func padRight(s string, width int) string {
+	if w := displayWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+// renderBox draws lines inside a border, one space in from each side. The
+// box is as wide as the widest line, and at least minWidth columns inside,
+// but never wider than the terminal; longer lines are cut short. Lines are
+// centered when center is set, otherwise left aligned.
+func renderBox(lines []string, style boxStyle, minWidth int, center bool) []string {
+	maxWidth := terminalWidth() - 2
+	width := minWidth
+	for _, line := range lines {
+		if w := displayWidth(line) + 2; w > width {
+			width = w
+		}
+	}
+	if width > maxWidth {
+		width = maxWidth
+	}
+
+	out := make([]string, 0, len(lines)+2)
+	out = append(out, style.TopLeft+strings.Repeat(style.Horizontal, width)+style.TopRight)
+	for _, line := range lines {
+		line = fitWidth(line, width-2)
+		left := 1
+		if center {
+			left = (width - displayWidth(line)) / 2
+		}
+		inner := padRight(strings.Repeat(" ", left)+line, width)
+		out = append(out, style.Vertical+inner+style.Vertical)
+	}
+	out = append(out, style.BottomLeft+strings.Repeat(style.Horizontal, width)+style.BottomRight)
+	return out
+}
+
+// printBox prints a box drawn by renderBox in the given color
+func printBox(c *color.Color, lines []string, style boxStyle, minWidth int, center bool) {
+	for _, line := range renderBox(lines, style, minWidth, center) {
+		c.Println(line)
+	}
+}
@@ -30,9 +30,16 @@ if %errorlevel% neq 0 (
     exit /b 1
 )
 
+REM Stamp the version the update check compares against: %VERSION% if set,
+REM otherwise the nearest git tag
+if not defined VERSION (
+    for /f "delims=" %%v in ('git describe --tags --dirty 2^>nul') do set VERSION=%%v
+)
+if not defined VERSION set VERSION=dev
+
 REM Build for Windows
-echo 🔨 Building CLI...
-go build -o focusforge-cli.exe -ldflags="-s -w" .
+echo 🔨 Building CLI %VERSION%...
+go build -o focusforge-cli.exe -ldflags="-s -w -X main.version=%VERSION%" .
 if %errorlevel% neq 0 (
     echo ❌ Build failed
     pause
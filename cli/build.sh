@@ -35,9 +35,14 @@ if [ $? -ne 0 ]; then
     exit 1
 fi
 
+# Stamp the version the update check compares against: $VERSION if set,
+# otherwise the nearest git tag
+VERSION="${VERSION:-$(git describe --tags --dirty 2>/dev/null)}"
+VERSION="${VERSION:-dev}"
+
 # Build for current platform
-echo "🔨 Building CLI..."
-go build -o focusforge-cli -ldflags="-s -w" .
+echo "🔨 Building CLI $VERSION..."
+go build -o focusforge-cli -ldflags="-s -w -X main.version=$VERSION" .
 
 if [ $? -ne 0 ]; then
     echo "❌ Build failed"
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// overdueTasks returns the unfinished tasks due before the start of today
+func overdueTasks(tasks []*Task, today time.Time) []*Task {
+	var overdue []*Task
+	for _, task := range tasks {
+		if task.Status != "pending" && task.Status != "in_progress" {
+			continue
+		}
+		if due, ok := parseTimestamp(task.DueDate); ok && due.Before(today) {
+			overdue = append(overdue, task)
+		}
+	}
+	return overdue
+}
+
+// carriedDueDate moves a due date to today, keeping its time of day
+func carriedDueDate(due, today time.Time) time.Time {
+	due = due.In(today.Location())
+	return time.Date(today.Year(), today.Month(), today.Day(), due.Hour(), due.Minute(), due.Second(), 0, today.Location())
+}
+
+func (c *FocusForgeCLI) carryOverTasks() {
+	color.Cyan("↪️  Carry Over")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load tasks")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+
+	loc := c.location
+	if loc == nil {
+		loc = time.Local
+	}
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	overdue := overdueTasks(resp.Tasks, today)
+	if len(overdue) == 0 {
+		color.Green("✓ Nothing to carry over - no unfinished tasks are overdue")
+		fmt.Println()
+		return
+	}
+
+	fmt.Printf("Unfinished tasks due before today (%d):\n", len(overdue))
+	for _, task := range overdue {
+		due, _ := parseTimestamp(task.DueDate)
+		fmt.Printf("  • %s - was due %s\n", fitWidth(task.Title, terminalWidth()-30), c.formatTime(due, "Mon Jan 2"))
+	}
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label: "What would you like to do with them?",
+		Items: []string{"📅 Move due dates to today", "🧹 Clear due dates", "❌ Cancel"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx == 2 {
+		return
+	}
+	if !c.confirmBulk(len(overdue)) {
+		return
+	}
+
+	updated := 0
+	for _, task := range overdue {
+		update := TaskUpdateRequest{ClearDueDate: true}
+		if idx == 0 {
+			due, _ := parseTimestamp(task.DueDate)
+			update = TaskUpdateRequest{DueDate: carriedDueDate(due, today).Format(time.RFC3339)}
+		}
+
+		resp, err := c.apiClient.UpdateTask(task.ID, update)
+		if err != nil {
+			color.Red("❌ Failed to update \"%s\": %v", task.Title, err)
+			continue
+		}
+		if !resp.Success {
+			color.Red("❌ Failed to update \"%s\": %s", task.Title, errorMessage(resp))
+			continue
+		}
+		updated++
+	}
+	appLog.Info("tasks carried over", "updated", updated, "total", len(overdue), "cleared", idx == 1)
+
+	if updated == len(overdue) {
+		color.Green("✓ Carried over %d tasks", updated)
+	} else {
+		color.Yellow("⚠️  Carried over %d of %d tasks", updated, len(overdue))
+	}
+	fmt.Println()
+}
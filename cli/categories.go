@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// otherCategory is the select entry for typing a brand-new category
+const otherCategory = "Other..."
+
+// mergeCategories returns the default categories followed by any extra
+// categories in used, sorted and without case-insensitive duplicates
+func mergeCategories(defaults, used []string) []string {
+	seen := map[string]bool{}
+	merged := make([]string, 0, len(defaults)+len(used))
+	for _, category := range defaults {
+		seen[strings.ToLower(category)] = true
+		merged = append(merged, category)
+	}
+
+	var extra []string
+	for _, category := range used {
+		category = strings.TrimSpace(category)
+		key := strings.ToLower(category)
+		if category == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		extra = append(extra, category)
+	}
+	sort.Strings(extra)
+
+	return append(merged, extra...)
+}
+
+// containsFold reports whether categories holds category, ignoring case
+func containsFold(categories []string, category string) bool {
+	for _, known := range categories {
+		if strings.EqualFold(known, category) {
+			return true
+		}
+	}
+	return false
+}
+
+// categories returns the configured category list, or the built-in one if
+// the user hasn't set their own
+func (cfg *Config) categories() []string {
+	if len(cfg.Categories) == 0 {
+		return taskCategories
+	}
+	return cfg.Categories
+}
+
+// categoryOptions returns the categories to offer when filing a task: the
+// configured list, then categories the user has used on existing tasks and
+// current, so a task filed under a removed category can keep it
+func (c *FocusForgeCLI) categoryOptions(current string) []string {
+	used := []string{current}
+	if c.apiClient != nil {
+		if resp, err := c.apiClient.GetTasks("", "", 200); err == nil && resp.Success {
+			for _, task := range resp.Tasks {
+				used = append(used, task.Category)
+			}
+		}
+	}
+	return mergeCategories(c.config.categories(), used)
+}
+
+// selectCategory asks for a task category, offering known categories and an
+// option to type a new one
+func (c *FocusForgeCLI) selectCategory(label, current string) (string, error) {
+	categories := c.categoryOptions(current)
+	items := append(categories, otherCategory)
+
+	categoryPrompt := promptui.Select{
+		Label:     label,
+		Items:     items,
+		CursorPos: indexOf(items, current),
+		Size:      10,
+	}
+	_, category, err := categoryPrompt.Run()
+	if err != nil {
+		return "", err
+	}
+	if category != otherCategory {
+		return category, nil
+	}
+
+	newPrompt := promptui.Prompt{
+		Label: "New category",
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("category cannot be empty")
+			}
+			return nil
+		},
+	}
+	category, err = newPrompt.Run()
+	if err != nil {
+		return "", err
+	}
+
+	// Reuse the existing spelling if the category is already known
+	category = strings.TrimSpace(category)
+	for _, known := range categories {
+		if strings.EqualFold(known, category) {
+			return known, nil
+		}
+	}
+	return category, nil
+}
+
+func (c *FocusForgeCLI) showCategorySettings() {
+	for {
+		color.Cyan("🗂️  Categories")
+		fmt.Println()
+
+		fmt.Println("These are offered whenever you choose a category for a task. Tasks")
+		fmt.Println("already filed under a removed category keep it.")
+		fmt.Println()
+		categories := c.config.categories()
+		for i, category := range categories {
+			if category == c.config.DefaultCategory {
+				fmt.Printf("  %d. %s (default)\n", i+1, category)
+			} else {
+				fmt.Printf("  %d. %s\n", i+1, category)
+			}
+		}
+		fmt.Println()
+
+		prompt := promptui.Select{
+			Label: "What would you like to do?",
+			Items: []string{"➕ Add Category", "🗑️  Remove Category", "↕️  Move Category", "⭐ Set Default", "↩️  Reset to Defaults", "🔙 Back"},
+		}
+		_, choice, err := prompt.Run()
+		if err != nil || choice == "🔙 Back" {
+			return
+		}
+
+		items := append([]string(nil), categories...)
+		switch choice {
+		case "➕ Add Category":
+			addPrompt := promptui.Prompt{
+				Label: "New category",
+				Validate: func(input string) error {
+					input = strings.TrimSpace(input)
+					if input == "" {
+						return fmt.Errorf("category cannot be empty")
+					}
+					if containsFold(items, input) {
+						return fmt.Errorf("%s is already on the list", input)
+					}
+					return nil
+				},
+			}
+			category, err := addPrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.Categories = append(items, strings.TrimSpace(category))
+		case "🗑️  Remove Category":
+			if len(items) <= 1 {
+				color.Yellow("Keep at least one category.")
+				fmt.Println()
+				continue
+			}
+			removePrompt := promptui.Select{
+				Label: "Remove which category?",
+				Items: items,
+			}
+			idx, _, err := removePrompt.Run()
+			if err != nil {
+				continue
+			}
+			removed := items[idx]
+			c.config.Categories = append(items[:idx], items[idx+1:]...)
+			if c.config.DefaultCategory == removed {
+				c.config.DefaultCategory = c.config.Categories[0]
+				color.Yellow("The default category is now %s", c.config.DefaultCategory)
+			}
+		case "↕️  Move Category":
+			movePrompt := promptui.Select{
+				Label: "Move which category?",
+				Items: items,
+			}
+			from, _, err := movePrompt.Run()
+			if err != nil {
+				continue
+			}
+			positions := make([]string, len(items))
+			for i := range items {
+				positions[i] = strconv.Itoa(i + 1)
+			}
+			toPrompt := promptui.Select{
+				Label:     fmt.Sprintf("New position for %s", items[from]),
+				Items:     positions,
+				CursorPos: from,
+			}
+			to, _, err := toPrompt.Run()
+			if err != nil || to == from {
+				continue
+			}
+			moved := items[from]
+			items = append(items[:from], items[from+1:]...)
+			items = append(items[:to], append([]string{moved}, items[to:]...)...)
+			c.config.Categories = items
+		case "⭐ Set Default":
+			defaultPrompt := promptui.Select{
+				Label:     "Default category for new tasks",
+				Items:     items,
+				CursorPos: indexOf(items, c.config.DefaultCategory),
+			}
+			_, category, err := defaultPrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.DefaultCategory = category
+		case "↩️  Reset to Defaults":
+			c.config.Categories = nil
+			if !containsFold(taskCategories, c.config.DefaultCategory) {
+				c.config.DefaultCategory = taskCategories[0]
+			}
+		}
+
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Settings saved")
+		}
+		fmt.Println()
+	}
+}
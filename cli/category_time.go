@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// categoryWindow is a time window offered by the time-by-category view. A
+// zero span means all time.
+type categoryWindow struct {
+	Label string
+	Span  time.Duration
+}
+
+var categoryWindows = []categoryWindow{
+	{"Last 7 days", 7 * 24 * time.Hour},
+	{"Last 30 days", 30 * 24 * time.Hour},
+	{"Last 90 days", 90 * 24 * time.Hour},
+	{"All time", 0},
+}
+
+// categoryBarWidth is the width of the longest bar in the chart
+const categoryBarWidth = 30
+
+// categoryMinutes is the focus time spent on one category
+type categoryMinutes struct {
+	category string
+	minutes  int
+}
+
+func (c *FocusForgeCLI) showTimeByCategory() {
+	color.Cyan("🗂️  Time by Category")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load focus time")
+		fmt.Println()
+		return
+	}
+
+	labels := make([]string, len(categoryWindows))
+	for i, w := range categoryWindows {
+		labels[i] = w.Label
+	}
+	windowPrompt := promptui.Select{
+		Label: "Time window",
+		Items: labels,
+	}
+	idx, _, err := windowPrompt.Run()
+	if err != nil {
+		return
+	}
+	window := categoryWindows[idx]
+
+	stop := startSpinner("Loading sessions and tasks")
+	sessionResp, sessionErr := c.apiClient.GetSessionHistory(500)
+	taskResp, taskErr := c.apiClient.GetTasks("", "", 500)
+	stop()
+	if sessionErr != nil {
+		color.Red("❌ Failed to fetch session history: %v", sessionErr)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !sessionResp.Success {
+		color.Red("❌ Failed to fetch session history: %s", errorMessage(sessionResp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if taskErr != nil {
+		color.Red("❌ Failed to fetch tasks: %v", taskErr)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !taskResp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(taskResp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	categories := map[string]string{}
+	for _, task := range taskResp.Tasks {
+		categories[task.ID] = task.Category
+	}
+
+	since := time.Time{}
+	if window.Span > 0 {
+		since = time.Now().Add(-window.Span)
+	}
+
+	totals := map[string]int{}
+	total := 0
+	for _, session := range sessionResp.Sessions {
+		if session.Aborted || session.ActualMinutes <= 0 {
+			continue
+		}
+		if started, ok := parseTimestamp(session.StartedAt); !ok || started.Before(since) {
+			continue
+		}
+		category := strings.TrimSpace(categories[session.TaskID])
+		if category == "" {
+			category = "other"
+		}
+		totals[category] += session.ActualMinutes
+		total += session.ActualMinutes
+	}
+
+	if total == 0 {
+		color.Yellow("No completed focus sessions in this window yet.")
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	rows := make([]categoryMinutes, 0, len(totals))
+	width := 0
+	for category, minutes := range totals {
+		rows = append(rows, categoryMinutes{category, minutes})
+		if len(category) > width {
+			width = len(category)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].minutes != rows[j].minutes {
+			return rows[i].minutes > rows[j].minutes
+		}
+		return rows[i].category < rows[j].category
+	})
+
+	// Shrink the bars to fit narrow terminals, leaving room for the labels
+	barWidth := categoryBarWidth
+	if room := terminalWidth() - width - 24; room < barWidth {
+		barWidth = room
+	}
+	if barWidth < 5 {
+		barWidth = 5
+	}
+
+	fmt.Printf("%s - %s of focus\n\n", window.Label, c.formatMinutes(total))
+	for _, row := range rows {
+		bar := row.minutes * barWidth / rows[0].minutes
+		if bar == 0 {
+			bar = 1
+		}
+		share := float64(row.minutes) * 100 / float64(total)
+		// Pad with spaces by hand: %-*s counts bytes, and █ is three
+		fmt.Printf("  %-*s %s%s %5.1f%%  (%s)\n", width, row.category,
+			color.CyanString(strings.Repeat("█", bar)), strings.Repeat(" ", barWidth-bar),
+			share, c.formatMinutes(row.minutes))
+	}
+	fmt.Println()
+	waitForEnter()
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// How much fuss celebrate makes
+const (
+	celebrationNone   = "none"
+	celebrationSubtle = "subtle"
+	celebrationFull   = "full"
+)
+
+// celebrationLevels lists the celebration settings offered, quietest first
+var celebrationLevels = []string{celebrationNone, celebrationSubtle, celebrationFull}
+
+// confettiPieces are scattered above and below a full celebration
+var confettiPieces = []string{"*", "+", "o", "~", "✦", "•", "🎉", "✨", "🎊"}
+
+// confettiWidth is how many columns a line of confetti spans
+const confettiWidth = 40
+
+// confetti returns one line of randomly scattered confetti
+func confetti() string {
+	var b strings.Builder
+	colors := []color.Attribute{color.FgRed, color.FgYellow, color.FgGreen, color.FgCyan, color.FgMagenta}
+	for width := 0; width < confettiWidth; {
+		if rand.Intn(3) == 0 {
+			piece := confettiPieces[rand.Intn(len(confettiPieces))]
+			b.WriteString(color.New(colors[rand.Intn(len(colors))]).Sprint(piece))
+			width += displayWidth(piece)
+		} else {
+			b.WriteByte(' ')
+			width++
+		}
+	}
+	return b.String()
+}
+
+// withTokens adds the tokens earned to a celebration message
+func withTokens(message string, tokens int) string {
+	if tokens <= 0 {
+		return message
+	}
+	return fmt.Sprintf("%s  +%d 🪙", message, tokens)
+}
+
+// celebrate marks a completion with as much fuss as the user asked for: a
+// plain confirmation, a single cheerful line, or a banner with confetti. It
+// never waits for input.
+func (c *FocusForgeCLI) celebrate(message string) {
+	switch c.config.Celebration {
+	case celebrationNone:
+		color.Green("✓ %s", message)
+	case celebrationFull:
+		fmt.Println(confetti())
+		printBox(color.New(color.FgGreen, color.Bold), []string{"🎉 " + message + " 🎉"}, doubleBox, confettiWidth-2, true)
+		fmt.Println(confetti())
+	default:
+		color.Green("🎉 %s", message)
+	}
+}
+
+// celebrateAchievements celebrates each achievement the backend unlocked
+func (c *FocusForgeCLI) celebrateAchievements(achievements []Achievement) {
+	for _, a := range achievements {
+		c.celebrate(withTokens(fmt.Sprintf("Achievement unlocked: %s - %s", a.Name, a.Description), a.Reward))
+	}
+}
+
+func (c *FocusForgeCLI) showCelebrationSettings() {
+	color.Cyan("🎉 Celebrations")
+	fmt.Println()
+
+	fmt.Println("How should finishing a task, reaching a goal or unlocking an")
+	fmt.Println("achievement be marked?")
+	fmt.Println("  none   - a plain confirmation")
+	fmt.Println("  subtle - a single cheerful line")
+	fmt.Println("  full   - a banner with confetti")
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label:     "Celebration",
+		Items:     celebrationLevels,
+		CursorPos: indexOf(celebrationLevels, c.config.Celebration),
+	}
+	_, level, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	c.config.Celebration = level
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+
+	if level != celebrationNone {
+		c.celebrate("This is how it will look")
+		fmt.Println()
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// autoChainCountdown is how long the user has to cancel before the next
+// block starts on its own
+const autoChainCountdown = 10 * time.Second
+
+// errKeyWaitUnsupported is returned where a keypress can't be waited for
+// with a timeout, e.g. when stdin is not a terminal
+var errKeyWaitUnsupported = errors.New("waiting for a keypress is not supported here")
+
+// nextBlock returns the first block of a task that isn't completed yet, or
+// nil if every block is done
+func nextBlock(task *Task) *TaskBlock {
+	for _, block := range task.Blocks {
+		if !block.IsCompleted() {
+			return block
+		}
+	}
+	return nil
+}
+
+// countdown shows a message counting down from d, returning false if the user
+// pressed a key to cancel. Where a keypress can't be waited for, it asks
+// instead.
+func countdown(d time.Duration, message string) bool {
+	for left := d; left > 0; left -= time.Second {
+		fmt.Printf("\r%s in %s - press any key to cancel ", message, formatCountdown(left))
+		_, pressed, err := readKeyWithin(time.Second)
+		if errors.Is(err, errKeyWaitUnsupported) {
+			fmt.Println()
+			return confirmContinue(message)
+		}
+		if err != nil || pressed {
+			fmt.Println()
+			return false
+		}
+	}
+	fmt.Println()
+	return true
+}
+
+// formatCountdown renders the time left as seconds, or minutes and seconds
+// once it is a minute or more
+func formatCountdown(d time.Duration) string {
+	seconds := int(d.Round(time.Second).Seconds())
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// confirmContinue asks whether to go ahead with what message describes
+func confirmContinue(message string) bool {
+	prompt := promptui.Select{
+		Label: message + "?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	return err == nil && choice == "Yes"
+}
+
+// chainNextBlock runs after a completed session when auto-chaining is on. It
+// checks off the block the session worked through, then after a cancelable
+// countdown and the preset's break, starts a session on the task's next block.
+func (c *FocusForgeCLI) chainNextBlock(session *focusSession) {
+	if !c.config.AutoChainBlocks || c.apiClient == nil || session.isFreeform() {
+		return
+	}
+
+	if session.BlockID != "" {
+		resp, err := c.apiClient.CompleteBlock(session.TaskID, session.BlockID)
+		if err != nil {
+			color.Red("❌ Failed to complete block: %v", err)
+			return
+		}
+		if !resp.Success {
+			color.Red("❌ Failed to complete block: %s", errorMessage(resp))
+			return
+		}
+	}
+
+	resp, err := c.apiClient.GetTask(session.TaskID)
+	if err != nil {
+		color.Red("❌ Failed to load the next block: %v", err)
+		return
+	}
+	if !resp.Success || resp.Task == nil {
+		color.Red("❌ Failed to load the next block: %s", errorMessage(resp))
+		return
+	}
+	task := resp.Task
+	block := nextBlock(task)
+	if block == nil {
+		if len(task.Blocks) > 0 {
+			color.Green("🎉 That was the last block of \"%s\"", task.Title)
+		}
+		return
+	}
+
+	fmt.Println()
+	color.Cyan("🔗 Next block: %s (%d min)", block.Title, block.DurationMinutes)
+	breakMinutes := c.config.preset().BreakMinutes
+	message := fmt.Sprintf("☕ Starting a %d-minute break", breakMinutes)
+	if !countdown(autoChainCountdown, message) {
+		color.Yellow("Auto-chaining cancelled")
+		return
+	}
+
+	if !countdown(time.Duration(breakMinutes)*time.Minute, fmt.Sprintf("▶️  Break! \"%s\" starts", block.Title)) {
+		color.Yellow("Auto-chaining cancelled")
+		return
+	}
+
+	duration := block.DurationMinutes
+	if duration <= 0 {
+		duration = c.config.preset().FocusMinutes
+	}
+	if _, err := c.launchSession(task, duration, block.ID, "", ""); err != nil {
+		color.Red("❌ Failed to start session: %v", err)
+		return
+	}
+	appLog.Info("block chained", "task", task.ID, "block", block.ID)
+	color.Green("✓ Focus session started on: %s - %s", task.Title, block.Title)
+	fmt.Printf("  Duration: %d minutes\n", duration)
+}
+
+func (c *FocusForgeCLI) showAutoChainSettings() {
+	color.Cyan("🔗 Auto-Chain Blocks")
+	fmt.Println()
+
+	fmt.Println("When a focus session on a broken-down task ends, auto-chaining checks off")
+	fmt.Println("the block, gives you your preset's break and starts the next block. A")
+	fmt.Printf("%d-second countdown before each step lets you opt out with any key.\n", int(autoChainCountdown.Seconds()))
+	fmt.Println()
+	fmt.Printf("Auto-chain blocks: %s\n", onOff(c.config.AutoChainBlocks))
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label: "Start the next block automatically after a session?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	c.config.AutoChainBlocks = choice == "Yes"
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+		fmt.Println()
+		return
+	}
+
+	color.Green("✓ Settings saved")
+	fmt.Println()
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// defaultChecklist is the pre-flight checklist used until the user edits it
+var defaultChecklist = []string{"Phone away", "Water ready", "Distracting tabs closed"}
+
+// checklist returns the configured checklist items, or the defaults if none
+// are set
+func (cfg *Config) checklist() []string {
+	if len(cfg.ChecklistItems) == 0 {
+		return defaultChecklist
+	}
+	return cfg.ChecklistItems
+}
+
+// runChecklist shows the pre-flight checklist, when enabled, and reports
+// whether the session should start. Skipping the checklist still starts it.
+func (c *FocusForgeCLI) runChecklist() bool {
+	if !c.config.Checklist {
+		return true
+	}
+
+	fmt.Println()
+	color.Cyan("📋 Before you start:")
+	for _, item := range c.config.checklist() {
+		fmt.Printf("  ☐ %s\n", item)
+	}
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label: "Ready?",
+		Items: []string{"✅ Ready - start the timer", "⏭️  Skip the checklist this time", "❌ Cancel"},
+	}
+	idx, _, err := prompt.Run()
+	return err == nil && idx != 2
+}
+
+// maxStartBuffer caps the get-ready countdown, in seconds
+const maxStartBuffer = 300
+
+// runStartBuffer counts down the configured get-ready time before a session
+// is started on the backend, reporting whether to go ahead. Any key cancels.
+func (c *FocusForgeCLI) runStartBuffer() bool {
+	if c.config.StartBuffer <= 0 {
+		return true
+	}
+	return countdown(time.Duration(c.config.StartBuffer)*time.Second, "🧘 Get ready - focus starts")
+}
+
+// startBufferLabel describes the get-ready countdown for settings menus
+func startBufferLabel(seconds int) string {
+	if seconds <= 0 {
+		return "off"
+	}
+	return fmt.Sprintf("%ds", seconds)
+}
+
+func (c *FocusForgeCLI) showChecklistSettings() {
+	for {
+		color.Cyan("📋 Session Checklist")
+		fmt.Println()
+
+		fmt.Println("When enabled, the checklist is shown before each focus session starts")
+		fmt.Println("as a short ritual to get ready for focused work.")
+		fmt.Println()
+		fmt.Printf("Checklist: %s\n", onOff(c.config.Checklist))
+		for _, item := range c.config.checklist() {
+			fmt.Printf("  ☐ %s\n", item)
+		}
+		fmt.Println()
+
+		toggle := "✅ Enable"
+		if c.config.Checklist {
+			toggle = "🚫 Disable"
+		}
+		prompt := promptui.Select{
+			Label: "What would you like to do?",
+			Items: []string{toggle, "➕ Add Item", "🗑️  Remove Item", "↩️  Reset to Defaults", "🔙 Back"},
+		}
+		_, choice, err := prompt.Run()
+		if err != nil || choice == "🔙 Back" {
+			return
+		}
+
+		items := append([]string(nil), c.config.checklist()...)
+		switch choice {
+		case "✅ Enable", "🚫 Disable":
+			c.config.Checklist = !c.config.Checklist
+		case "➕ Add Item":
+			itemPrompt := promptui.Prompt{
+				Label: "Checklist item",
+				Validate: func(input string) error {
+					if strings.TrimSpace(input) == "" {
+						return fmt.Errorf("item cannot be empty")
+					}
+					return nil
+				},
+			}
+			item, err := itemPrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.ChecklistItems = append(items, strings.TrimSpace(item))
+		case "🗑️  Remove Item":
+			if len(items) <= 1 {
+				color.Yellow("Keep at least one item, or disable the checklist.")
+				fmt.Println()
+				continue
+			}
+			removePrompt := promptui.Select{
+				Label: "Remove which item?",
+				Items: items,
+			}
+			idx, _, err := removePrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.ChecklistItems = append(items[:idx], items[idx+1:]...)
+		case "↩️  Reset to Defaults":
+			c.config.ChecklistItems = nil
+		}
+
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Settings saved")
+		}
+		fmt.Println()
+	}
+}
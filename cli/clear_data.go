@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+)
+
+// localFile is one file Clear Local Data can remove
+type localFile struct {
+	Name string
+	Path string
+	Size int64
+}
+
+// formatBytes renders a file size in B, KB or MB
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
+
+// localDataFiles returns the cache and state files that exist on disk. The
+// config file, weekly reviews and soundscape audio are never included.
+func localDataFiles() ([]localFile, error) {
+	logFile, err := logPath()
+	if err != nil {
+		return nil, err
+	}
+	candidates := []struct {
+		name string
+		path func() (string, error)
+	}{
+		{"Offline queue", queuePath},
+		{"Session state", sessionStatePath},
+		{"Update check cache", updateCachePath},
+		{"Debug log", logPath},
+		{"Rotated debug log", func() (string, error) { return logFile + ".1", nil }},
+	}
+
+	var files []localFile
+	for _, candidate := range candidates {
+		path, err := candidate.path()
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s: %v", path, err)
+		}
+		files = append(files, localFile{Name: candidate.name, Path: path, Size: info.Size()})
+	}
+	return files, nil
+}
+
+// clearLocalData removes cached responses, the offline queue, the debug log
+// and any leftover session state after listing them and asking first. The
+// config is kept.
+func (c *FocusForgeCLI) clearLocalData() {
+	color.Cyan("🧹 Clear Local Data")
+	fmt.Println()
+
+	files, err := localDataFiles()
+	if err != nil {
+		color.Red("❌ Failed to find local data: %v", err)
+		fmt.Println()
+		return
+	}
+
+	// The running session's state is its crash recovery, so leave it be
+	if active := c.session(); active != nil {
+		kept := files[:0]
+		for _, file := range files {
+			if file.Name == "Session state" {
+				dimmed.Printf("Keeping the session state while your session on \"%s\" is running.\n", active.TaskTitle)
+				fmt.Println()
+				continue
+			}
+			kept = append(kept, file)
+		}
+		files = kept
+	}
+	if len(files) == 0 {
+		color.Green("✓ Nothing to clear")
+		fmt.Println()
+		return
+	}
+
+	var total int64
+	for _, file := range files {
+		fmt.Printf("  %-20s %10s  %s\n", file.Name, formatBytes(file.Size), file.Path)
+		total += file.Size
+	}
+	fmt.Printf("  %-20s %10s\n", "Total", formatBytes(total))
+	fmt.Println()
+	dimmed.Println("Your settings, weekly reviews and soundscape files are kept.")
+	fmt.Println()
+
+	if queue, err := loadQueue(); err == nil && len(queue) > 0 {
+		color.Yellow("⚠️  %d change(s) made offline have not been synced yet and will be lost.", len(queue))
+		dimmed.Println("Use \"🔄 Sync Now\" first to keep them.")
+		fmt.Println()
+	}
+
+	if !confirmContinue(fmt.Sprintf("Delete these %d file(s)", len(files))) {
+		color.Yellow("Nothing was cleared")
+		fmt.Println()
+		return
+	}
+
+	// Hold off queue flushes and release the log file while deleting
+	c.flushMu.Lock()
+	c.queueMu.Lock()
+	closeLogging()
+	cleared := 0
+	for _, file := range files {
+		if err := os.Remove(file.Path); err != nil && !os.IsNotExist(err) {
+			color.Red("❌ Failed to delete %s: %v", file.Name, err)
+			continue
+		}
+		color.Green("✓ Cleared %s (%s)", file.Name, formatBytes(file.Size))
+		cleared++
+	}
+	c.queueMu.Unlock()
+	c.flushMu.Unlock()
+	if err := setupLogging(c.config); err != nil {
+		color.Red("❌ Failed to reopen log file: %v", err)
+	}
+
+	appLog.Info("local data cleared", "files", cleared)
+	fmt.Println()
+	color.Green("✓ Cleared %d of %d file(s)", cleared, len(files))
+	fmt.Println()
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// copyLabel is the menu item that offers to copy details to the clipboard
+const copyLabel = "📋 Copy"
+
+// copyToClipboard puts text on the system clipboard, telling the user what
+// was copied or why it couldn't be
+func copyToClipboard(what, text string) {
+	if clipboard.Unsupported {
+		color.Yellow("⚠️  No clipboard available on this system")
+		fmt.Println()
+		return
+	}
+	if err := clipboard.WriteAll(text); err != nil {
+		color.Yellow("⚠️  Couldn't copy to the clipboard: %v", err)
+		fmt.Println()
+		return
+	}
+	color.Green("✓ Copied %s to the clipboard", what)
+	fmt.Println()
+}
+
+// taskSummary formats a task as a short plain-text reference for sharing
+func (c *FocusForgeCLI) taskSummary(task *Task) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s [%s]\n", task.Title, task.ID)
+	fmt.Fprintf(&b, "%s · %s priority · %d min · %s", task.Category, task.Priority, task.DurationMinutes, task.Status)
+	if task.DueDate != "" {
+		fmt.Fprintf(&b, " · due %s", c.formatTimestamp(task.DueDate))
+	}
+	if len(task.Blocks) > 0 {
+		done := 0
+		for _, block := range task.Blocks {
+			if block.IsCompleted() {
+				done++
+			}
+		}
+		fmt.Fprintf(&b, " · %d/%d blocks done", done, len(task.Blocks))
+	}
+	if task.Description != "" {
+		fmt.Fprintf(&b, "\n%s", task.Description)
+	}
+	return b.String()
+}
+
+// copyTaskDetails lets the user copy a task's ID or a shareable summary
+func (c *FocusForgeCLI) copyTaskDetails(task *Task) {
+	prompt := promptui.Select{
+		Label: "What would you like to copy?",
+		Items: []string{"Task ID", "Summary", "🔙 Back"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	switch choice {
+	case "Task ID":
+		copyToClipboard("the task ID", task.ID)
+	case "Summary":
+		copyToClipboard("the task summary", c.taskSummary(task))
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// noColorLabel is the select choice for leaving a task unlabeled
+const noColorLabel = "none"
+
+// colorLabels lists the color names a task can be labeled with, in the order
+// they are offered
+var colorLabels = []string{noColorLabel, "red", "green", "yellow", "blue", "magenta", "cyan"}
+
+// colorLabelAttributes maps each color label to how it is rendered
+var colorLabelAttributes = map[string]color.Attribute{
+	"red":     color.FgRed,
+	"green":   color.FgGreen,
+	"yellow":  color.FgYellow,
+	"blue":    color.FgBlue,
+	"magenta": color.FgMagenta,
+	"cyan":    color.FgCyan,
+}
+
+// labeledTitle renders a task's title in its color label, or plainly when it
+// has no label or one this version doesn't know. Titles longer than max
+// columns are cut short; a max of 0 means no limit.
+func labeledTitle(task *Task, max int) string {
+	title := fitWidth(task.Title, max)
+	attr, ok := colorLabelAttributes[task.ColorLabel]
+	if !ok {
+		return title
+	}
+	return color.New(attr).Sprint(title)
+}
+
+// selectColorLabel asks for a task's color label, returning "" for none
+func selectColorLabel() (string, error) {
+	items := make([]string, len(colorLabels))
+	for i, name := range colorLabels {
+		items[i] = name
+		if attr, ok := colorLabelAttributes[name]; ok {
+			items[i] = color.New(attr).Sprint("● ") + name
+		}
+	}
+
+	prompt := promptui.Select{
+		Label: "Color label (optional)",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return "", err
+	}
+	if colorLabels[idx] == noColorLabel {
+		return "", nil
+	}
+	return colorLabels[idx], nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+// maxCommitmentSessions caps how many sessions can be committed to in a day
+const maxCommitmentSessions = 20
+
+// todaysCommitment fetches today's commitment, returning nil if none was
+// made or it could not be loaded
+func (c *FocusForgeCLI) todaysCommitment() *Commitment {
+	if c.apiClient == nil {
+		return nil
+	}
+	resp, err := c.apiClient.GetCommitment(c.dayKey(c.now()))
+	if err != nil || !resp.Success || resp.Commitment == nil || resp.Commitment.TargetSessions <= 0 {
+		return nil
+	}
+	return resp.Commitment
+}
+
+// commitmentProgress renders a commitment as "2/4 sessions" with a bar
+func commitmentProgress(m *Commitment) string {
+	bar := ""
+	for i := 0; i < m.TargetSessions; i++ {
+		if i < m.CompletedSessions {
+			bar += "●"
+		} else {
+			bar += "○"
+		}
+	}
+	progress := fmt.Sprintf("%s %d/%d sessions", bar, m.CompletedSessions, m.TargetSessions)
+	if m.Met() {
+		return color.GreenString(progress)
+	}
+	return progress
+}
+
+func (c *FocusForgeCLI) showCommitment() {
+	color.Cyan("🤝 Daily Commitment")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot manage commitments")
+		fmt.Println()
+		return
+	}
+
+	current := 4
+	if m := c.todaysCommitment(); m != nil {
+		fmt.Printf("Today's commitment: %s\n", commitmentProgress(m))
+		fmt.Println()
+		current = m.TargetSessions
+	} else {
+		fmt.Println("You haven't made a commitment for today yet.")
+		fmt.Println()
+	}
+
+	count, ok := promptInt("Focus sessions you commit to completing today", current, 1, maxCommitmentSessions)
+	if !ok {
+		return
+	}
+
+	resp, err := c.apiClient.SetCommitment(c.dayKey(c.now()), count)
+	if err != nil {
+		color.Red("❌ Failed to save commitment: %v", err)
+	} else if !resp.Success {
+		color.Red("❌ Failed to save commitment: %s", errorMessage(resp))
+	} else {
+		color.Green("✓ Committed to %d focus sessions today", count)
+		if resp.Commitment != nil {
+			fmt.Printf("  Progress: %s\n", commitmentProgress(resp.Commitment))
+		}
+	}
+	fmt.Println()
+}
+
+// reportCommitmentProgress shows how a just-finished session moved today's
+// commitment, celebrating the session that fulfils it
+func (c *FocusForgeCLI) reportCommitmentProgress() {
+	m := c.todaysCommitment()
+	if m == nil {
+		return
+	}
+	if m.CompletedSessions == m.TargetSessions {
+		c.celebrate(fmt.Sprintf("Commitment kept! You completed all %d sessions you promised today.", m.TargetSessions))
+	} else {
+		fmt.Printf("  Commitment: %s\n", commitmentProgress(m))
+	}
+}
+
+// noteMissedCommitment gently mentions yesterday's commitment if it was not
+// kept. It is checked at startup and mentioned only once per day missed.
+func (c *FocusForgeCLI) noteMissedCommitment() {
+	yesterday := c.dayKey(c.now().AddDate(0, 0, -1))
+	if c.config.MissedCommitment == yesterday {
+		return
+	}
+	resp, err := c.apiClient.GetCommitment(yesterday)
+	if err != nil || !resp.Success || resp.Commitment == nil {
+		return
+	}
+	m := resp.Commitment
+	if m.TargetSessions <= 0 || m.Met() {
+		return
+	}
+	color.Yellow("🤝 Yesterday you completed %d of the %d sessions you committed to. Today's a fresh start!", m.CompletedSessions, m.TargetSessions)
+	fmt.Println()
+
+	c.config.MissedCommitment = yesterday
+	if err := saveConfig(c.config); err != nil {
+		color.Yellow("⚠️  %v", err)
+	}
+}
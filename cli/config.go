@@ -0,0 +1,252 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// Config holds the user's persisted CLI settings
+type Config struct {
+	APIURL              string                       `json:"api_url"`
+	UserID              string                       `json:"user_id,omitempty"`
+	Token               string                       `json:"token,omitempty"`
+	DefaultCategory     string                       `json:"default_category,omitempty"`
+	Categories          []string                     `json:"categories,omitempty"`
+	PomodoroPreset      string                       `json:"pomodoro_preset,omitempty"`
+	DoNotDisturb        bool                         `json:"do_not_disturb"`
+	ClearScreen         bool                         `json:"clear_screen_between_menus"`
+	ShowBanner          bool                         `json:"show_banner"`
+	Timezone            string                       `json:"timezone,omitempty"`
+	Soundscape          string                       `json:"soundscape,omitempty"`
+	SoundVolume         int                          `json:"sound_volume"`
+	Bell                bool                         `json:"bell"`
+	CompletionSound     string                       `json:"completion_sound,omitempty"`
+	BudgetWarnings      bool                         `json:"budget_warnings"`
+	BudgetThreshold     int                          `json:"budget_threshold_percent"`
+	ConfirmThreshold    int                          `json:"confirm_threshold"`
+	Onboarded           bool                         `json:"onboarded"`
+	Keybindings         map[string]string            `json:"keybindings,omitempty"`
+	IdleDetection       bool                         `json:"idle_detection"`
+	IdleMinutes         int                          `json:"idle_minutes"`
+	ListLimit           int                          `json:"default_list_limit"`
+	RateLimit           int                          `json:"max_requests_per_second"`
+	SessionLength       string                       `json:"session_length,omitempty"`
+	StartBuffer         int                          `json:"start_buffer_seconds"`
+	RetryAttempts       int                          `json:"retry_attempts"`
+	RetryBaseDelay      int                          `json:"retry_base_delay_ms"`
+	RetryMaxDelay       int                          `json:"retry_max_delay_ms"`
+	WeeklyFocusGoal     int                          `json:"weekly_focus_goal_hours,omitempty"`
+	DuplicateTitleCheck bool                         `json:"duplicate_title_check"`
+	UpdateCheck         bool                         `json:"update_check"`
+	UpdateURL           string                       `json:"update_url,omitempty"`
+	DurationRounding    string                       `json:"duration_rounding,omitempty"`
+	Theme               string                       `json:"theme,omitempty"`
+	FileLogging         bool                         `json:"file_logging"`
+	LogLevel            string                       `json:"log_level,omitempty"`
+	Metrics             bool                         `json:"client_metrics"`
+	StrictFocus         bool                         `json:"strict_focus"`
+	AutoChainBlocks     bool                         `json:"auto_chain_blocks"`
+	MoodAfterSession    bool                         `json:"mood_after_session"`
+	Celebration         string                       `json:"celebration,omitempty"`
+	IntensityLabels     map[int]string               `json:"intensity_labels,omitempty"`
+	Checklist           bool                         `json:"session_checklist"`
+	ChecklistItems      []string                     `json:"session_checklist_items,omitempty"`
+	Vacation            bool                         `json:"vacation_mode"`
+	VacationUntil       string                       `json:"vacation_until,omitempty"`
+	MissedCommitment    string                       `json:"missed_commitment_noted,omitempty"`
+	Profiles            map[string]string            `json:"profiles,omitempty"`
+	EndpointOverrides   map[string]string            `json:"endpoint_overrides,omitempty"`
+	Templates           map[string]TaskCreateRequest `json:"task_templates,omitempty"`
+}
+
+// pomodoroPreset is a named focus/break length pair
+type pomodoroPreset struct {
+	Name         string
+	FocusMinutes int
+	BreakMinutes int
+}
+
+// pomodoroPresets lists the presets offered to the user, shortest first
+var pomodoroPresets = []pomodoroPreset{
+	{Name: "classic", FocusMinutes: 25, BreakMinutes: 5},
+	{Name: "extended", FocusMinutes: 50, BreakMinutes: 10},
+	{Name: "deep", FocusMinutes: 90, BreakMinutes: 20},
+}
+
+// taskCategories lists the categories a task can be filed under until the
+// user sets their own
+var taskCategories = []string{"work", "personal", "learning", "health", "other"}
+
+// defaultConfig returns the settings used when no config file exists yet
+func defaultConfig() *Config {
+	return &Config{
+		APIURL:              "http://localhost:8000",
+		DefaultCategory:     "work",
+		PomodoroPreset:      "classic",
+		ShowBanner:          true,
+		Timezone:            "Local",
+		SoundVolume:         50,
+		Bell:                true,
+		BudgetWarnings:      true,
+		BudgetThreshold:     10,
+		ConfirmThreshold:    5,
+		IdleMinutes:         15,
+		ListLimit:           defaultListLimit,
+		RateLimit:           defaultRateLimit,
+		SessionLength:       sessionLengthPrompt,
+		DuplicateTitleCheck: true,
+		DurationRounding:    roundingMinute,
+		Theme:               themeDefault,
+		LogLevel:            "info",
+		Celebration:         celebrationSubtle,
+		RetryAttempts:       defaultRetryAttempts,
+		RetryBaseDelay:      defaultRetryBaseDelay,
+		RetryMaxDelay:       defaultRetryMaxDelay,
+	}
+}
+
+// clone returns a deep copy of cfg, so that decoding into the copy can't
+// change cfg's maps and slices
+func (cfg *Config) clone() *Config {
+	copied := *cfg
+	copied.Categories = slices.Clone(cfg.Categories)
+	copied.ChecklistItems = slices.Clone(cfg.ChecklistItems)
+	copied.Keybindings = maps.Clone(cfg.Keybindings)
+	copied.IntensityLabels = maps.Clone(cfg.IntensityLabels)
+	copied.Profiles = maps.Clone(cfg.Profiles)
+	copied.EndpointOverrides = maps.Clone(cfg.EndpointOverrides)
+	copied.Templates = maps.Clone(cfg.Templates)
+	return &copied
+}
+
+// defaultRateLimit is how many requests per second the CLI sends at most,
+// to spare small self-hosted backends
+const defaultRateLimit = 10
+
+// Bounds for how many items list views fetch
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// listLimit returns how many items list views fetch, falling back to the
+// default if the configured value is out of range
+func (cfg *Config) listLimit() int {
+	if cfg.ListLimit < 1 || cfg.ListLimit > maxListLimit {
+		return defaultListLimit
+	}
+	return cfg.ListLimit
+}
+
+// preset returns the configured Pomodoro preset, falling back to classic
+func (cfg *Config) preset() pomodoroPreset {
+	for _, p := range pomodoroPresets {
+		if p.Name == cfg.PomodoroPreset {
+			return p
+		}
+	}
+	return pomodoroPresets[0]
+}
+
+// configDir returns the directory holding the CLI's local files
+func configDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate home directory: %v", err)
+	}
+	return filepath.Join(home, ".focusforge"), nil
+}
+
+// configPath returns the location of the config file
+func configPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.json"), nil
+}
+
+// Environment variables that override config values at startup
+const (
+	envAPIURL = "FOCUSFORGE_API_URL"
+	envUserID = "FOCUSFORGE_USER_ID"
+	envToken  = "FOCUSFORGE_TOKEN"
+
+	// envDuration pre-fills the duration of new tasks, for scripted runs
+	envDuration = "FOCUSFORGE_DURATION"
+)
+
+// Sources a setting's effective value can come from, highest precedence first
+const (
+	sourceFlag    = "command-line flag"
+	sourceEnv     = "environment"
+	sourceConfig  = "config file"
+	sourceDefault = "default"
+)
+
+// resolveSetting picks a setting's effective value using the precedence
+// flag > environment > config > default, and reports where it came from
+func resolveSetting(flagValue, envName, configValue, defaultValue string) (string, string) {
+	if flagValue != "" {
+		return flagValue, sourceFlag
+	}
+	if envValue := os.Getenv(envName); envValue != "" {
+		return envValue, sourceEnv
+	}
+	if configValue != "" && configValue != defaultValue {
+		return configValue, sourceConfig
+	}
+	return defaultValue, sourceDefault
+}
+
+// loadConfig reads the config file, falling back to defaults for a missing
+// file or missing fields
+func loadConfig() (*Config, error) {
+	cfg := defaultConfig()
+
+	path, err := configPath()
+	if err != nil {
+		return cfg, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read config: %v", err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return defaultConfig(), fmt.Errorf("failed to parse config %s: %v", path, err)
+	}
+
+	return cfg, nil
+}
+
+// saveConfig writes cfg to the config file, creating the directory if needed
+func saveConfig(cfg *Config) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %v", err)
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %v", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// dimmed renders blocked tasks in lists
+var dimmed = color.New(color.Faint)
+
+// indexTasks maps task IDs to tasks for dependency lookups
+func indexTasks(tasks []*Task) map[string]*Task {
+	index := make(map[string]*Task, len(tasks))
+	for _, task := range tasks {
+		if task.ID != "" {
+			index[task.ID] = task
+		}
+	}
+	return index
+}
+
+// blockingTasks returns the prerequisites of task that are not completed yet.
+// Only direct dependencies are checked, so a dependency cycle can never make
+// this loop; tasks in a cycle simply show each other as blockers. Self
+// references and IDs missing from index (deleted or not fetched) are ignored.
+func blockingTasks(task *Task, index map[string]*Task) []*Task {
+	var blockers []*Task
+	seen := make(map[string]bool, len(task.DependsOn))
+	for _, id := range task.DependsOn {
+		if id == task.ID || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if dep, ok := index[id]; ok && dep.Status != "completed" {
+			blockers = append(blockers, dep)
+		}
+	}
+	return blockers
+}
+
+// prerequisitesDone reports whether task can be worked on, warning about
+// the unfinished prerequisites if it can't. Tasks are looked up fresh, since
+// callers may hold a task without its dependencies. If they can't be loaded
+// the session is allowed, as the backend has the final say.
+func (c *FocusForgeCLI) prerequisitesDone(task *Task) bool {
+	if task.ID == "" {
+		return true
+	}
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil || !resp.Success {
+		appLog.Warn("couldn't check prerequisites", "task", task.ID, "error", err)
+		return true
+	}
+	index := indexTasks(resp.Tasks)
+	current, ok := index[task.ID]
+	if !ok {
+		return true
+	}
+	blockers := blockingTasks(current, index)
+	if len(blockers) == 0 {
+		return true
+	}
+	color.Yellow("🔒 \"%s\" is waiting on: %s", task.Title, taskTitles(blockers))
+	return false
+}
+
+// taskTitles joins task titles for display
+func taskTitles(tasks []*Task) string {
+	titles := make([]string, len(tasks))
+	for i, task := range tasks {
+		titles[i] = task.Title
+	}
+	return strings.Join(titles, ", ")
+}
+
+// selectPrerequisites lets the user toggle any number of tasks as
+// prerequisites and returns the IDs of the selected ones
+func selectPrerequisites(tasks []*Task) []string {
+	const done = "✓ Done"
+
+	selected := make(map[string]bool)
+	for {
+		items := make([]string, 0, len(tasks)+1)
+		items = append(items, done)
+		for _, task := range tasks {
+			mark := "[ ]"
+			if selected[task.ID] {
+				mark = "[x]"
+			}
+			items = append(items, fmt.Sprintf("%s %s", mark, task.Title))
+		}
+
+		prompt := promptui.Select{
+			Label: "Select prerequisite tasks",
+			Items: items,
+			Size:  10,
+		}
+		idx, _, err := prompt.Run()
+		if err != nil || idx == 0 {
+			break
+		}
+
+		id := tasks[idx-1].ID
+		selected[id] = !selected[id]
+	}
+
+	var ids []string
+	for _, task := range tasks {
+		if selected[task.ID] {
+			ids = append(ids, task.ID)
+		}
+	}
+	return ids
+}
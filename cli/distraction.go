@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+// distractionLabel is the session control that counts a distraction
+const distractionLabel = "📵 Got Distracted"
+
+// noteDistraction counts a distraction in session without touching its
+// timer
+func (c *FocusForgeCLI) noteDistraction(session *focusSession) {
+	count := session.addDistraction()
+	session.touch()
+	c.persistSession(session)
+	color.Yellow("📵 Distraction noted (%d this session) - back to it!", count)
+}
+
+// logDistraction is the quick action for noting a distraction in the active
+// session
+func (c *FocusForgeCLI) logDistraction() {
+	session := c.session()
+	if session == nil {
+		color.Yellow("No active session")
+		fmt.Println()
+		return
+	}
+	c.noteDistraction(session)
+	fmt.Println()
+}
+
+// averageDistractions returns the mean distraction count of sessions
+func averageDistractions(sessions []*Session) float64 {
+	if len(sessions) == 0 {
+		return 0
+	}
+	total := 0
+	for _, s := range sessions {
+		total += s.Distractions
+	}
+	return float64(total) / float64(len(sessions))
+}
+
+// printDistractionTrend compares distractions per session in recent and
+// older sessions. Nothing is shown until some distractions were recorded.
+func printDistractionTrend(recent, older []*Session) {
+	r, o := averageDistractions(recent), averageDistractions(older)
+	switch {
+	case r == 0 && o == 0:
+		return
+	case r < o:
+		color.Green("📵 Fewer distractions lately (%.1f → %.1f per session)", o, r)
+	case r > o:
+		color.Yellow("📵 More distractions lately (%.1f → %.1f per session)", o, r)
+	default:
+		color.Cyan("📵 Distractions are steady at %.1f per session", r)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"errors"
+	"runtime"
+
+	"github.com/fatih/color"
+)
+
+// errDoNotDisturbUnsupported is returned by platformSetDoNotDisturb on
+// platforms where the CLI has no way to toggle notifications
+var errDoNotDisturbUnsupported = errors.New("do not disturb is not supported on this platform")
+
+// setDoNotDisturb turns the OS Do Not Disturb / focus mode on or off when the
+// user has enabled the integration. Failures are reported but never abort the
+// session flow that triggered them.
+func (c *FocusForgeCLI) setDoNotDisturb(on bool) {
+	if c.config == nil || !c.config.DoNotDisturb {
+		return
+	}
+
+	err := platformSetDoNotDisturb(on)
+	if errors.Is(err, errDoNotDisturbUnsupported) {
+		color.Yellow("ℹ️  Do Not Disturb is not supported on %s - skipping", runtime.GOOS)
+		return
+	}
+	if err != nil {
+		color.Yellow("⚠️  Could not change Do Not Disturb: %v", err)
+		return
+	}
+
+	if on {
+		color.Green("🔕 Do Not Disturb enabled")
+	} else {
+		color.Green("🔔 Do Not Disturb disabled")
+	}
+}
@@ -0,0 +1,31 @@
+//go:build darwin
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// On macOS, Focus modes can only be scripted through Shortcuts. The user
+// creates two shortcuts with these names that turn Do Not Disturb on and off.
+const (
+	macFocusOnShortcut  = "FocusForge Focus On"
+	macFocusOffShortcut = "FocusForge Focus Off"
+)
+
+func platformSetDoNotDisturb(on bool) error {
+	if _, err := exec.LookPath("shortcuts"); err != nil {
+		return errDoNotDisturbUnsupported
+	}
+
+	name := macFocusOffShortcut
+	if on {
+		name = macFocusOnShortcut
+	}
+
+	if out, err := exec.Command("shortcuts", "run", name).CombinedOutput(); err != nil {
+		return fmt.Errorf("shortcut %q failed: %v (%s)", name, err, out)
+	}
+	return nil
+}
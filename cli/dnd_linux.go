@@ -0,0 +1,26 @@
+//go:build linux
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// On Linux only GNOME exposes a scriptable notification toggle
+func platformSetDoNotDisturb(on bool) error {
+	if _, err := exec.LookPath("gsettings"); err != nil {
+		return errDoNotDisturbUnsupported
+	}
+
+	showBanners := "true"
+	if on {
+		showBanners = "false"
+	}
+
+	cmd := exec.Command("gsettings", "set", "org.gnome.desktop.notifications", "show-banners", showBanners)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("gsettings failed: %v (%s)", err, out)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+//go:build !darwin && !linux
+
+package main
+
+func platformSetDoNotDisturb(on bool) error {
+	return errDoNotDisturbUnsupported
+}
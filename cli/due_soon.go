@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// dueWindows are the windows offered by the Due Soon view
+var dueWindows = []string{"Today", "Next 3 days", "This week"}
+
+// dueWindowEnd returns when a due window closes, counting from the start of
+// today. Weeks end after Sunday.
+func dueWindowEnd(window string, today time.Time) time.Time {
+	switch window {
+	case "Next 3 days":
+		return today.AddDate(0, 0, 3)
+	case "This week":
+		daysLeft := (7 - int(today.Weekday())) % 7
+		return today.AddDate(0, 0, daysLeft+1)
+	default:
+		return today.AddDate(0, 0, 1)
+	}
+}
+
+// dueBefore returns the unfinished tasks with a due date before end,
+// including overdue ones, soonest first. Tasks without a due date are left
+// out.
+func dueBefore(tasks []*Task, end time.Time) []*Task {
+	var due []*Task
+	for _, task := range tasks {
+		if task.Status == "completed" {
+			continue
+		}
+		if t, ok := parseTimestamp(task.DueDate); ok && t.Before(end) {
+			due = append(due, task)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		a, _ := parseTimestamp(due[i].DueDate)
+		b, _ := parseTimestamp(due[j].DueDate)
+		return a.Before(b)
+	})
+	return due
+}
+
+func (c *FocusForgeCLI) showDueSoon() {
+	color.Cyan("📆 Due Soon")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load tasks")
+		fmt.Println()
+		return
+	}
+
+	windowPrompt := promptui.Select{
+		Label: "Show tasks due",
+		Items: dueWindows,
+	}
+	_, window, err := windowPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+
+	now := c.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	due := dueBefore(resp.Tasks, dueWindowEnd(window, today))
+	if len(due) == 0 {
+		color.Green("✓ Nothing due (%s)", strings.ToLower(window))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	overdue := color.New(color.FgRed, color.Bold)
+	for _, task := range due {
+		t, _ := parseTimestamp(task.DueDate)
+		when := c.formatTime(t, "Mon Jan 2 15:04")
+		title := labeledTitle(task, terminalWidth()-30)
+		if t.Before(now) {
+			overdue.Printf("  ⚠️  %s  %s (overdue)\n", when, title)
+		} else {
+			fmt.Printf("  📅 %s  %s\n", when, title)
+		}
+	}
+	fmt.Println()
+	waitForEnter()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// findDuplicateTitle returns the first unfinished task titled title,
+// ignoring case and surrounding space, or nil if there is none
+func findDuplicateTitle(tasks []*Task, title string) *Task {
+	title = strings.TrimSpace(title)
+	for _, task := range tasks {
+		if task.Status != "completed" && strings.EqualFold(strings.TrimSpace(task.Title), title) {
+			return task
+		}
+	}
+	return nil
+}
+
+// confirmDuplicateTitle warns when an unfinished task already has title and
+// asks whether to create another. It reports whether to go ahead, which is
+// always true when the check is off or the lookup fails.
+func (c *FocusForgeCLI) confirmDuplicateTitle(title string) bool {
+	if !c.config.DuplicateTitleCheck || c.apiClient == nil {
+		return true
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil || !resp.Success {
+		return true
+	}
+	existing := findDuplicateTitle(resp.Tasks, title)
+	if existing == nil {
+		return true
+	}
+
+	color.Yellow("⚠️  You already have a task called \"%s\" (%s)", existing.Title, existing.Status)
+	prompt := promptui.Select{
+		Label: "Create it anyway?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil || choice != "Yes" {
+		color.Yellow("Task not created")
+		fmt.Println()
+		return false
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Ways durations can be rounded for display
+const (
+	roundingMinute      = "minute"
+	roundingFiveMinutes = "5min"
+	roundingExact       = "exact"
+)
+
+// durationRoundings lists the rounding options offered in settings
+var durationRoundings = []string{roundingMinute, roundingFiveMinutes, roundingExact}
+
+// formatDuration renders d as e.g. "1h 5m", rounded to the nearest minute,
+// the nearest 5 minutes, or to the second for "exact" (e.g. "1h 5m 12s").
+// Unknown rounding values round to the minute.
+func formatDuration(d time.Duration, rounding string) string {
+	if d < 0 {
+		d = 0
+	}
+
+	switch rounding {
+	case roundingExact:
+		d = d.Round(time.Second)
+	case roundingFiveMinutes:
+		d = d.Round(5 * time.Minute)
+	default:
+		d = d.Round(time.Minute)
+	}
+
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	seconds := int(d.Seconds()) % 60
+
+	s := fmt.Sprintf("%dm", minutes)
+	if hours > 0 {
+		s = fmt.Sprintf("%dh %s", hours, s)
+	}
+	if rounding == roundingExact && seconds > 0 {
+		s = fmt.Sprintf("%s %ds", s, seconds)
+	}
+	return s
+}
+
+// formatMinutes renders a whole number of minutes with the configured
+// rounding
+func (c *FocusForgeCLI) formatMinutes(minutes int) string {
+	return formatDuration(time.Duration(minutes)*time.Minute, c.config.DurationRounding)
+}
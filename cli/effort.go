@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/manifoldco/promptui"
+)
+
+// effortPoints lists the allowed effort estimates, story-point style
+var effortPoints = []int{1, 2, 3, 5, 8}
+
+// validateEffort checks that points is one of the allowed estimates, or 0
+// for no estimate
+func validateEffort(points int) error {
+	if points == 0 {
+		return nil
+	}
+	for _, allowed := range effortPoints {
+		if points == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("effort must be one of %v", effortPoints)
+}
+
+// selectEffort asks how much effort a task takes, returning 0 if the user
+// skips the estimate
+func selectEffort() (int, error) {
+	items := []string{"skip"}
+	for _, points := range effortPoints {
+		items = append(items, strconv.Itoa(points))
+	}
+
+	prompt := promptui.Select{
+		Label: "Effort points (how hard, not how long)",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx == 0 {
+		return 0, err
+	}
+	return effortPoints[idx-1], nil
+}
+
+// effortSummary renders completed against total effort, or "" if no task
+// has an estimate
+func effortSummary(stats *TaskStats) string {
+	if stats.TotalEffort == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d of %d pts", stats.CompletedEffort, stats.TotalEffort)
+}
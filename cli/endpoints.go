@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Resource groups whose requests can be routed to their own base URL
+const (
+	resourceTasks        = "tasks"
+	resourceMood         = "mood"
+	resourceSessions     = "sessions"
+	resourceGamification = "gamification"
+)
+
+// endpointResources lists the resource groups that accept an override
+var endpointResources = []string{resourceTasks, resourceMood, resourceSessions, resourceGamification}
+
+// isEndpointResource reports whether resource is a group that accepts an
+// override
+func isEndpointResource(resource string) bool {
+	for _, r := range endpointResources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// validateEndpointURL checks that raw is an absolute http(s) URL
+func validateEndpointURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL must start with http:// or https://")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("URL has no host")
+	}
+	return nil
+}
+
+// SetEndpointOverrides routes each resource group in overrides to its own
+// base URL. Groups without an override keep using the main API URL.
+func (c *APIClient) SetEndpointOverrides(overrides map[string]string) {
+	c.endpoints = make(map[string]string, len(overrides))
+	for resource, base := range overrides {
+		c.endpoints[resource] = strings.TrimRight(base, "/")
+	}
+}
+
+// baseFor returns the base URL requests for resource are sent to
+func (c *APIClient) baseFor(resource string) string {
+	if base, ok := c.endpoints[resource]; ok && base != "" {
+		return base
+	}
+	return c.baseURL
+}
+
+// endpointOverrides returns the configured overrides that are safe to use,
+// warning about and skipping unknown groups and invalid URLs
+func (c *FocusForgeCLI) endpointOverrides() map[string]string {
+	overrides := make(map[string]string, len(c.config.EndpointOverrides))
+	for resource, base := range c.config.EndpointOverrides {
+		if base == "" {
+			continue
+		}
+		if !isEndpointResource(resource) {
+			color.Yellow("⚠️  Ignoring endpoint override for unknown resource %q (expected one of %s)", resource, strings.Join(endpointResources, ", "))
+			continue
+		}
+		if err := validateEndpointURL(base); err != nil {
+			color.Yellow("⚠️  Ignoring %s endpoint override %q: %v", resource, base, err)
+			continue
+		}
+		overrides[resource] = base
+	}
+	return overrides
+}
+
+// printEndpointOverrides lists the resource groups routed away from the main
+// API URL
+func (c *FocusForgeCLI) printEndpointOverrides() {
+	if len(c.config.EndpointOverrides) == 0 {
+		return
+	}
+	resources := make([]string, 0, len(c.config.EndpointOverrides))
+	for resource := range c.config.EndpointOverrides {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	color.Cyan("Endpoint overrides (from config file):")
+	for _, resource := range resources {
+		fmt.Printf("  %s → %s\n", resource, c.config.EndpointOverrides[resource])
+	}
+	fmt.Println()
+}
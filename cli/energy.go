@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// energyLevels are the energy levels a session can be started at, lowest
+// first
+var energyLevels = []string{"low", "medium", "high"}
+
+// energyIcons decorates energy levels in prompts and tables
+var energyIcons = map[string]string{"low": "🪫", "medium": "🔋", "high": "⚡"}
+
+// minEnergySessions is how many sessions with an energy level are needed
+// before the energy analysis is shown
+const minEnergySessions = 5
+
+// selectEnergy asks for the user's energy level with a single select. It
+// returns "" when skipped, and false if the prompt was cancelled.
+func selectEnergy() (string, bool) {
+	items := []string{"Skip"}
+	for _, level := range energyLevels {
+		items = append(items, fmt.Sprintf("%s %s", energyIcons[level], level))
+	}
+	prompt := promptui.Select{
+		Label: "Energy level right now? (optional)",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return "", false
+	}
+	if idx == 0 {
+		return "", true
+	}
+	return energyLevels[idx-1], true
+}
+
+// energyStats aggregates the sessions started at one energy level
+type energyStats struct {
+	sessions  int
+	completed int
+	score     int
+	minutes   int
+}
+
+func (c *FocusForgeCLI) showEnergyAnalysis() {
+	color.Cyan("🔋 Energy vs Focus")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot analyze sessions")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetSessionHistory(200)
+	if err != nil {
+		color.Red("❌ Failed to fetch session history: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch session history: %s", errorMessage(resp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	byLevel := map[string]*energyStats{}
+	total := 0
+	for _, session := range resp.Sessions {
+		if energyIcons[session.Energy] == "" {
+			continue
+		}
+		stats := byLevel[session.Energy]
+		if stats == nil {
+			stats = &energyStats{}
+			byLevel[session.Energy] = stats
+		}
+		stats.sessions++
+		stats.score += session.FocusScore
+		stats.minutes += session.ActualMinutes
+		if !session.Aborted {
+			stats.completed++
+		}
+		total++
+	}
+
+	if total < minEnergySessions {
+		color.Yellow("Not enough data yet - note your energy level when starting at least %d sessions to see how it affects your focus.", minEnergySessions)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	fmt.Printf("%-10s %9s %10s %11s %10s\n", "Energy", "Sessions", "Avg score", "Completed", "Avg focus")
+	best, bestScore := "", -1.0
+	for _, level := range energyLevels {
+		stats := byLevel[level]
+		if stats == nil {
+			continue
+		}
+		avg := float64(stats.score) / float64(stats.sessions)
+		if avg > bestScore {
+			best, bestScore = level, avg
+		}
+		fmt.Printf("%s %-7s %9d %s %10.0f%% %10s\n", energyIcons[level], level, stats.sessions,
+			scoreColor(int(avg)).Sprintf("%10.0f", avg),
+			100*float64(stats.completed)/float64(stats.sessions),
+			c.formatMinutes(stats.minutes/stats.sessions))
+	}
+	fmt.Println()
+
+	if len(byLevel) > 1 {
+		color.Green("💡 You focus best at %s energy - schedule demanding tasks for those times.", best)
+		fmt.Println()
+	}
+	waitForEnter()
+}
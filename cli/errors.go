@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Error kinds returned by APIClient methods. Callers can tell them apart
+// with errors.Is; errors carrying an HTTP status are also *StatusError.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("not authorized - check your user ID and API token")
+	ErrRateLimited  = errors.New("rate limited by the server - try again shortly")
+	ErrServer       = errors.New("the server hit an internal error")
+	ErrNetwork      = errors.New("failed to make request")
+	ErrDecode       = errors.New("failed to decode response")
+	ErrTimeout      = errors.New("request timed out")
+	ErrValidation   = errors.New("the server rejected the input")
+)
+
+// StatusError is returned when the backend answers with an HTTP status that
+// maps to one of the error kinds above
+type StatusError struct {
+	StatusCode int
+	Kind       error
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%v (HTTP %d)", e.Kind, e.StatusCode)
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Kind
+}
+
+// statusError classifies an HTTP status, returning nil for statuses whose
+// body should be decoded as a normal response
+func statusError(code int) error {
+	var kind error
+	switch {
+	case code == http.StatusNotFound:
+		kind = ErrNotFound
+	case code == http.StatusUnauthorized || code == http.StatusForbidden:
+		kind = ErrUnauthorized
+	case code == http.StatusTooManyRequests:
+		kind = ErrRateLimited
+	case code >= 500:
+		kind = ErrServer
+	default:
+		return nil
+	}
+	return &StatusError{StatusCode: code, Kind: kind}
+}
+
+// ValidationError is returned when the backend rejects a request's input
+// with HTTP 422. Fields maps each offending field to what was wrong with it.
+type ValidationError struct {
+	Fields  map[string]string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		if e.Message != "" {
+			return fmt.Sprintf("%v: %s", ErrValidation, e.Message)
+		}
+		return ErrValidation.Error()
+	}
+	parts := make([]string, 0, len(e.Fields))
+	for _, field := range e.fieldNames() {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, e.Fields[field]))
+	}
+	return fmt.Sprintf("%v (%s)", ErrValidation, strings.Join(parts, "; "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// fieldNames returns the rejected fields in alphabetical order
+func (e *ValidationError) fieldNames() []string {
+	names := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validationBody covers the 422 bodies the backend may send: FastAPI's
+// list of {loc, msg} details, or a map of field errors
+type validationBody struct {
+	Detail json.RawMessage   `json:"detail"`
+	Errors map[string]string `json:"errors"`
+	Error  string            `json:"error"`
+}
+
+// validationDetail is one entry of a FastAPI validation error
+type validationDetail struct {
+	Loc []interface{} `json:"loc"`
+	Msg string        `json:"msg"`
+}
+
+// parseValidationError reads a 422 response body into a *ValidationError,
+// keeping whatever field messages it can make sense of
+func parseValidationError(body io.Reader) error {
+	verr := &ValidationError{Fields: map[string]string{}}
+
+	var parsed validationBody
+	if err := json.NewDecoder(body).Decode(&parsed); err != nil {
+		return verr
+	}
+	for field, msg := range parsed.Errors {
+		verr.Fields[field] = msg
+	}
+	verr.Message = parsed.Error
+
+	var details []validationDetail
+	if err := json.Unmarshal(parsed.Detail, &details); err == nil {
+		for _, d := range details {
+			if len(d.Loc) == 0 {
+				continue
+			}
+			// The last element of loc names the field; earlier ones say
+			// where it was found, e.g. ["body", "title"]
+			verr.Fields[fmt.Sprint(d.Loc[len(d.Loc)-1])] = d.Msg
+		}
+	} else {
+		var message string
+		if err := json.Unmarshal(parsed.Detail, &message); err == nil && verr.Message == "" {
+			verr.Message = message
+		}
+	}
+	return verr
+}
+
+// responseError returns the error for a response's HTTP status, parsing
+// the body of validation failures, or nil if the body should be decoded as
+// a normal response
+func responseError(resp *http.Response) error {
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		return parseValidationError(resp.Body)
+	}
+	return statusError(resp.StatusCode)
+}
+
+// networkError wraps a transport failure as ErrNetwork
+func networkError(err error) error {
+	return fmt.Errorf("%w: %v", ErrNetwork, err)
+}
+
+// timeoutError reports a request that took longer than timeout as ErrTimeout
+func timeoutError(timeout time.Duration) error {
+	return fmt.Errorf("%w after %s - the backend may be busy; try again or raise --timeout", ErrTimeout, timeout)
+}
+
+// decodeError wraps a response decoding failure as ErrDecode
+func decodeError(err error) error {
+	return fmt.Errorf("%w: %v", ErrDecode, err)
+}
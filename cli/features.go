@@ -0,0 +1,60 @@
+package main
+
+// Backend feature flags that gate parts of the CLI
+const (
+	featureAISuggestions = "ai_suggestions"
+	featureGamification  = "gamification"
+	featureSpotify       = "spotify"
+	featureTaskSnooze    = "task_snooze"
+	featureRecurrences   = "recurring_tasks"
+	featureVacation      = "vacation_mode"
+)
+
+// menuFeatures maps menu items to the backend feature they need. Items not
+// listed here are always shown.
+var menuFeatures = map[string]string{
+	"🤖 Suggest Next":           featureAISuggestions,
+	"🏆 Gamification & Rewards": featureGamification,
+	"🎵 Spotify Integration":    featureSpotify,
+	"😴 Snooze Task":            featureTaskSnooze,
+	"📆 Recurring Tasks":        featureRecurrences,
+}
+
+// quickActionFeatures maps quick actions to the backend feature they need
+var quickActionFeatures = map[string]string{
+	"suggest_next": featureAISuggestions,
+}
+
+// loadFeatures asks the backend which optional features it supports. If the
+// backend can't say, features stays nil and everything is shown.
+func (c *FocusForgeCLI) loadFeatures() {
+	resp, err := c.apiClient.GetFeatures()
+	if err != nil || !resp.Success || resp.Features == nil {
+		return
+	}
+	c.mu.Lock()
+	c.features = resp.Features
+	c.mu.Unlock()
+}
+
+// featureEnabled reports whether the backend supports a feature. Features
+// the backend didn't mention, or all features when discovery failed, are
+// assumed to be supported.
+func (c *FocusForgeCLI) featureEnabled(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	enabled, ok := c.features[name]
+	return !ok || enabled
+}
+
+// availableItems drops menu items whose backend feature is disabled
+func (c *FocusForgeCLI) availableItems(items []string) []string {
+	available := make([]string, 0, len(items))
+	for _, item := range items {
+		if feature, ok := menuFeatures[item]; ok && !c.featureEnabled(feature) {
+			continue
+		}
+		available = append(available, item)
+	}
+	return available
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// freeformTitle labels sessions started without a task
+const freeformTitle = "🆓 Freeform focus (assign a task later)"
+
+// freeformTask is the placeholder picked to start a session with no task.
+// It has no ID and defaults to the configured preset's focus length.
+func (c *FocusForgeCLI) freeformTask() *Task {
+	return &Task{
+		Title:           freeformTitle,
+		DurationMinutes: c.config.preset().FocusMinutes,
+	}
+}
+
+// isFreeform reports whether the session has no task attached yet
+func (s *focusSession) isFreeform() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.TaskID == ""
+}
+
+// assignTask attaches task to the session
+func (s *focusSession) assignTask(task *Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.TaskID = task.ID
+	s.TaskTitle = task.Title
+}
+
+// assignSessionTask lets the user pick the task a freeform session was for
+// and attaches it on the backend. It reports whether a task was assigned.
+func (c *FocusForgeCLI) assignSessionTask(session *focusSession) bool {
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		return false
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		return false
+	}
+
+	var tasks []*Task
+	for _, task := range resp.Tasks {
+		if task.Status != "completed" {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) == 0 {
+		color.Yellow("No open tasks to assign.")
+		return false
+	}
+
+	task := selectTask("Which task was this session for?", tasks)
+	if task == nil {
+		return false
+	}
+
+	assignResp, err := c.apiClient.AssignSessionTask(session.ID, task.ID)
+	if err != nil {
+		color.Red("❌ Failed to assign task: %v", err)
+		return false
+	}
+	if !assignResp.Success {
+		color.Red("❌ Failed to assign task: %s", errorMessage(assignResp))
+		return false
+	}
+
+	session.assignTask(task)
+	c.persistSession(session)
+	color.Green("✓ Session assigned to: %s", task.Title)
+	return true
+}
+
+// offerSessionAssignment gives the user a last chance to attach a task
+// before a freeform session ends
+func (c *FocusForgeCLI) offerSessionAssignment(session *focusSession) {
+	prompt := promptui.Select{
+		Label: "This session has no task. Assign one before ending?",
+		Items: []string{"Yes, pick a task", "No, log it as freeform focus"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx != 0 {
+		return
+	}
+	c.assignSessionTask(session)
+	fmt.Println()
+}
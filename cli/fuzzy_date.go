@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fuzzyDateFormats lists the date forms parseFuzzyDate accepts, for prompts
+// and error messages
+const fuzzyDateFormats = `YYYY-MM-DD, "today", "yesterday", "tomorrow", "last week", "N days ago" or "N weeks ago"`
+
+// agoPattern matches relative dates such as "3 days ago" or "1 week ago"
+var agoPattern = regexp.MustCompile(`^(\d+)\s+(day|days|week|weeks)\s+ago$`)
+
+// parseFuzzyDate parses a date typed by the user, relative to now, and
+// returns midnight of that day in now's location
+func parseFuzzyDate(input string, now time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.Join(strings.Fields(input), " "))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch s {
+	case "today":
+		return today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "last week":
+		return today.AddDate(0, 0, -7), nil
+	}
+
+	if m := agoPattern.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("enter a date as %s", fuzzyDateFormats)
+		}
+		if strings.HasPrefix(m[2], "week") {
+			n *= 7
+		}
+		return today.AddDate(0, 0, -n), nil
+	}
+
+	if t, err := time.ParseInLocation(dateLayout, s, now.Location()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("enter a date as %s", fuzzyDateFormats)
+}
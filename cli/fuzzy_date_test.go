@@ -0,0 +1,62 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFuzzyDate(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Date(2024, time.March, 15, 18, 30, 0, 0, loc)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2024, month, d, 0, 0, 0, 0, loc)
+	}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"today", day(time.March, 15)},
+		{"  Today ", day(time.March, 15)},
+		{"yesterday", day(time.March, 14)},
+		{"tomorrow", day(time.March, 16)},
+		{"last week", day(time.March, 8)},
+		{"last   week", day(time.March, 8)},
+		{"1 day ago", day(time.March, 14)},
+		{"3 days ago", day(time.March, 12)},
+		{"20 days ago", day(time.February, 24)},
+		{"1 week ago", day(time.March, 8)},
+		{"2 weeks ago", day(time.March, 1)},
+		{"2024-03-01", day(time.March, 1)},
+		{"2023-12-31", time.Date(2023, time.December, 31, 0, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		got, err := parseFuzzyDate(tt.input, now)
+		if err != nil {
+			t.Errorf("parseFuzzyDate(%q) error: %v", tt.input, err)
+			continue
+		}
+		if !got.Equal(tt.want) || got.Location() != loc {
+			t.Errorf("parseFuzzyDate(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseFuzzyDateRejectsAmbiguousInput(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+	for _, input := range []string{"", "next friday", "03/04/2024", "a week ago", "3 months ago", "2024-13-01"} {
+		_, err := parseFuzzyDate(input, now)
+		if err == nil {
+			t.Errorf("parseFuzzyDate(%q) succeeded, want an error", input)
+			continue
+		}
+		want := "enter a date as " + fuzzyDateFormats
+		if err.Error() != want {
+			t.Errorf("parseFuzzyDate(%q) error = %q, want %q", input, err, want)
+		}
+		if !strings.Contains(err.Error(), "YYYY-MM-DD") {
+			t.Errorf("parseFuzzyDate(%q) error %q does not mention the accepted forms", input, err)
+		}
+	}
+}
@@ -3,13 +3,18 @@ module focusforge-cli
 go 1.21
 
 require (
+	github.com/atotto/clipboard v0.1.4
+	github.com/chzyer/readline v1.5.1
 	github.com/fatih/color v1.16.0
 	github.com/manifoldco/promptui v0.9.0
+	github.com/mattn/go-isatty v0.0.20
+	github.com/mattn/go-runewidth v0.0.15
+	golang.org/x/sys v0.14.0
+	golang.org/x/term v0.14.0
+	golang.org/x/time v0.5.0
 )
 
 require (
-	github.com/chzyer/readline v1.5.1 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
-	github.com/mattn/go-isatty v0.0.20 // indirect
-	golang.org/x/sys v0.14.0 // indirect
+	github.com/rivo/uniseg v0.2.0 // indirect
 )
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Background health check intervals. While the backend is unreachable the
+// interval doubles after each failed check up to healthCheckMaxInterval, and
+// drops back to healthCheckInterval as soon as a check succeeds.
+const (
+	healthCheckInterval    = 30 * time.Second
+	healthCheckMaxInterval = 5 * time.Minute
+	healthCheckJitter      = 0.2
+)
+
+// nextHealthInterval returns the delay before the next health check given
+// the previous delay and whether the last check succeeded
+func nextHealthInterval(previous time.Duration, connected bool) time.Duration {
+	if connected || previous <= 0 {
+		return healthCheckInterval
+	}
+	next := previous * 2
+	if next > healthCheckMaxInterval {
+		next = healthCheckMaxInterval
+	}
+	return next
+}
+
+// withJitter spreads d by up to ±healthCheckJitter so many clients that lost
+// the backend at the same moment don't all retry in lockstep
+func withJitter(d time.Duration) time.Duration {
+	spread := int64(float64(d) * healthCheckJitter)
+	if spread <= 0 {
+		return d
+	}
+	return d + time.Duration(rand.Int63n(2*spread+1)-spread)
+}
+
+// runHealthChecker keeps the connected flag up to date until the CLI shuts
+// down. It is meant to be run with goBackground.
+func (c *FocusForgeCLI) runHealthChecker() {
+	interval := healthCheckInterval
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(withJitter(interval)):
+		}
+
+		connected := c.client().HealthCheck() == nil
+		reconnected := connected && !c.isConnected()
+		c.setConnected(connected)
+		if reconnected {
+			c.syncInBackground()
+		}
+		interval = nextHealthInterval(interval, connected)
+	}
+}
+
+// syncInBackground sends any offline changes once the backend is reachable
+// again. Results only go to the log since the menus own the terminal.
+func (c *FocusForgeCLI) syncInBackground() {
+	if pendingSync() == 0 {
+		return
+	}
+	sent, dropped, err := c.flushQueue()
+	if err != nil {
+		appLog.Warn("offline sync failed", "error", err)
+	}
+	appLog.Info("offline sync finished", "sent", sent, "dropped", len(dropped))
+}
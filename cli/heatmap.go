@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// heatmapWeeks is how many weeks of activity the heatmap shows
+const heatmapWeeks = 12
+
+// heatmapLevel is one shade of the heatmap, used for days with at least
+// minMinutes of focus
+type heatmapLevel struct {
+	minMinutes int
+	cell       string
+	color      *color.Color
+}
+
+// heatmapLevels go from an empty day to the most focused, lowest first
+var heatmapLevels = []heatmapLevel{
+	{0, "·", color.New(color.FgHiBlack)},
+	{1, "░", color.New(color.FgGreen)},
+	{30, "▒", color.New(color.FgGreen)},
+	{60, "▓", color.New(color.FgHiGreen)},
+	{120, "█", color.New(color.FgHiGreen, color.Bold)},
+}
+
+// heatmapCell renders the shade for a day's focus minutes
+func heatmapCell(minutes int) string {
+	level := heatmapLevels[0]
+	for _, l := range heatmapLevels {
+		if minutes >= l.minMinutes {
+			level = l
+		}
+	}
+	return level.color.Sprint(level.cell)
+}
+
+func (c *FocusForgeCLI) showActivityHeatmap() {
+	color.Cyan("📅 Activity Heatmap")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load activity")
+		fmt.Println()
+		return
+	}
+
+	stop := startSpinner("Loading session history")
+	resp, err := c.apiClient.GetSessionHistory(500)
+	stop()
+	if err != nil {
+		color.Red("❌ Failed to fetch session history: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch session history: %s", errorMessage(resp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	minutes := map[string]int{}
+	for _, session := range resp.Sessions {
+		if t, ok := parseTimestamp(session.StartedAt); ok {
+			minutes[c.dayKey(t)] += session.ActualMinutes
+		}
+	}
+
+	// Columns are weeks starting on Monday, with the current week last
+	today := c.now()
+	offset := (int(today.Weekday()) + 6) % 7
+	start := time.Date(today.Year(), today.Month(), today.Day()-offset-7*(heatmapWeeks-1), 0, 0, 0, 0, today.Location())
+
+	fmt.Printf("%s – %s\n\n", start.Format("Jan 2"), today.Format("Jan 2, 2006"))
+
+	labels := []string{"Mon", "", "Wed", "", "Fri", "", "Sun"}
+	total, active := 0, 0
+	for row := 0; row < 7; row++ {
+		var line strings.Builder
+		fmt.Fprintf(&line, "%-4s", labels[row])
+		for week := 0; week < heatmapWeeks; week++ {
+			day := start.AddDate(0, 0, week*7+row)
+			if day.After(today) {
+				line.WriteString("  ")
+				continue
+			}
+			m := minutes[c.dayKey(day)]
+			if m > 0 {
+				total += m
+				active++
+			}
+			line.WriteString(" " + heatmapCell(m))
+		}
+		fmt.Println(line.String())
+	}
+
+	fmt.Println()
+	var legend strings.Builder
+	legend.WriteString("    Less")
+	for _, l := range heatmapLevels {
+		legend.WriteString(" " + l.color.Sprint(l.cell))
+	}
+	legend.WriteString(" More   (· none, ░ <30m, ▒ 30m+, ▓ 1h+, █ 2h+)")
+	fmt.Println(legend.String())
+
+	fmt.Println()
+	fmt.Printf("Active days: %d   Total focus: %s\n", active, c.formatMinutes(total))
+	fmt.Println()
+	waitForEnter()
+}
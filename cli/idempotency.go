@@ -0,0 +1,24 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// idempotencyHeader carries the key the backend uses to recognise a retried
+// create it has already carried out
+const idempotencyHeader = "Idempotency-Key"
+
+// newIdempotencyKey returns a random version 4 UUID. Generate one per
+// logical create and reuse it for every retry of that create.
+func newIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand does not fail on supported platforms; without a key the
+		// backend simply won't dedupe
+		return ""
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
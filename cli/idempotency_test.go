@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+// uuidV4 matches a version 4, RFC 4122 variant UUID
+var uuidV4 = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestNewIdempotencyKeyIsUUIDv4(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		key := newIdempotencyKey()
+		if !uuidV4.MatchString(key) {
+			t.Fatalf("newIdempotencyKey() = %q, want a version 4 UUID", key)
+		}
+		if seen[key] {
+			t.Fatalf("newIdempotencyKey() returned %q twice", key)
+		}
+		seen[key] = true
+	}
+}
+
+func TestRetriedCreateReusesIdempotencyKey(t *testing.T) {
+	var mu sync.Mutex
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get(idempotencyHeader))
+		first := len(keys) == 1
+		mu.Unlock()
+
+		if first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"success":true,"task":{"id":"t1","title":"Write report"}}`))
+	}))
+	defer srv.Close()
+
+	client := NewAPIClient(srv.URL, "tester")
+	req := TaskCreateRequest{
+		Title:           "Write report",
+		DurationMinutes: 25,
+		Category:        "work",
+		Priority:        "medium",
+		IdempotencyKey:  newIdempotencyKey(),
+	}
+
+	// The first attempt fails; the user retries, resending the same request
+	if _, err := client.CreateTask(req); !retryable(err) {
+		t.Fatalf("first CreateTask error = %v, want a retryable error", err)
+	}
+	if _, err := client.CreateTask(req); err != nil {
+		t.Fatalf("retried CreateTask: %v", err)
+	}
+
+	if len(keys) != 2 {
+		t.Fatalf("backend saw %d requests, want 2", len(keys))
+	}
+	if keys[0] != keys[1] {
+		t.Errorf("retry sent key %q, first attempt sent %q", keys[1], keys[0])
+	}
+	if !uuidV4.MatchString(keys[0]) {
+		t.Errorf("key %q is not a version 4 UUID", keys[0])
+	}
+}
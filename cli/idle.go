@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// idleGracePeriod is how long the user has to answer a "Still there?"
+// check-in before the timer is paused
+const idleGracePeriod = 2 * time.Minute
+
+// idleEvent is what an idle check decided to do
+type idleEvent int
+
+const (
+	idleNone idleEvent = iota
+	idleCheckIn
+	idlePause
+)
+
+// touch records an interaction with the session, cancelling any pending
+// check-in
+func (s *focusSession) touch() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastActivity = time.Now()
+	s.checkInAt = time.Time{}
+}
+
+// checkIdle decides whether the user should be asked if they are still
+// there, or the timer paused because they never answered. A pause is
+// backdated to the check-in so the unanswered time doesn't count as focus.
+func (s *focusSession) checkIdle(idleAfter time.Duration) idleEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.pausedAt.IsZero() {
+		return idleNone
+	}
+	now := time.Now()
+	if s.checkInAt.IsZero() {
+		if now.Sub(s.lastActivity) < idleAfter {
+			return idleNone
+		}
+		s.checkInAt = now
+		return idleCheckIn
+	}
+	if now.Sub(s.checkInAt) < idleGracePeriod {
+		return idleNone
+	}
+
+	s.pausedAt = s.checkInAt
+	s.pauses++
+	s.checkInAt = time.Time{}
+	s.idlePaused = true
+	return idlePause
+}
+
+// takeIdlePaused reports whether the session was paused for inactivity
+// since the last call
+func (s *focusSession) takeIdlePaused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	paused := s.idlePaused
+	s.idlePaused = false
+	return paused
+}
+
+// checkIdle runs the idle check for a session's timer tick and tells the user
+// what happened
+func (c *FocusForgeCLI) checkIdle(s *focusSession) {
+	switch s.checkIdle(time.Duration(c.config.IdleMinutes) * time.Minute) {
+	case idleCheckIn:
+		c.ringBell()
+		fmt.Println()
+		color.Yellow("👀 Still there? Open ⏸️  Current Session within %d minutes to keep the timer running.", int(idleGracePeriod.Minutes()))
+	case idlePause:
+		c.persistSession(s)
+		fmt.Println()
+		color.Yellow("⏸️  No activity - the session on \"%s\" has been paused.", s.TaskTitle)
+	}
+}
+
+// welcomeBack asks a user returning to a session paused for inactivity
+// whether to carry on
+func (c *FocusForgeCLI) welcomeBack(s *focusSession) {
+	if !s.takeIdlePaused() {
+		return
+	}
+
+	color.Yellow("⏸️  The timer was paused while you were away.")
+	prompt := promptui.Select{
+		Label: "Still there?",
+		Items: []string{"▶️  Yes, resume the timer", "⏸️  Keep it paused"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx != 0 {
+		fmt.Println()
+		return
+	}
+	s.resume()
+	c.persistSession(s)
+	color.Green("▶️  Welcome back - session resumed")
+	fmt.Println()
+}
+
+func (c *FocusForgeCLI) showIdleSettings() {
+	color.Cyan("💤 Idle Detection")
+	fmt.Println()
+
+	fmt.Println("When enabled, a session that sees no interaction for a while asks if")
+	fmt.Printf("you're still there, and pauses the timer if there's no answer within %d minutes.\n", int(idleGracePeriod.Minutes()))
+	fmt.Println()
+	fmt.Printf("Idle detection: %s\n", onOff(c.config.IdleDetection))
+	fmt.Printf("Check in after: %d minutes\n", c.config.IdleMinutes)
+	fmt.Println()
+
+	enablePrompt := promptui.Select{
+		Label: "Pause sessions automatically when you seem to be away?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := enablePrompt.Run()
+	if err != nil {
+		return
+	}
+	c.config.IdleDetection = choice == "Yes"
+
+	if c.config.IdleDetection {
+		if minutes, ok := promptInt("Minutes without activity before checking in", c.config.IdleMinutes, 1, 240); ok {
+			c.config.IdleMinutes = minutes
+		}
+	}
+
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// inboxCategory files quickly captured tasks until they are processed
+const inboxCategory = "inbox"
+
+// quickCaptureLabel is the main menu and session control for capturing a
+// task by title alone
+const quickCaptureLabel = "📥 Quick Capture"
+
+// quickCapture creates a task from just a title, filed in the inbox with the
+// default duration and priority to be filled in later
+func (c *FocusForgeCLI) quickCapture() {
+	color.Cyan("📥 Quick Capture")
+	fmt.Println()
+
+	titlePrompt := promptui.Prompt{
+		Label: "What's on your mind?",
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("title cannot be empty")
+			}
+			return nil
+		},
+	}
+	title, err := titlePrompt.Run()
+	if err != nil {
+		return
+	}
+
+	taskReq := TaskCreateRequest{
+		Title:           strings.TrimSpace(title),
+		DurationMinutes: c.config.preset().FocusMinutes,
+		Category:        inboxCategory,
+		Priority:        "medium",
+		IdempotencyKey:  newIdempotencyKey(),
+	}
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - task not captured")
+		fmt.Println()
+		return
+	}
+
+	var resp *TaskResponse
+	err = withRetryPrompt(func() error {
+		var err error
+		resp, err = c.apiClient.CreateTask(taskReq)
+		return err
+	})
+	if err != nil {
+		color.Red("❌ Failed to capture task: %v", err)
+		if offline(err) {
+			c.queueOffline(queuedMutation{Kind: mutationCreateTask, Task: &taskReq, IdempotencyKey: taskReq.IdempotencyKey})
+		}
+	} else if !resp.Success {
+		color.Red("❌ Failed to capture task: %s", errorMessage(resp))
+	} else {
+		appLog.Info("task captured to inbox")
+		color.Green("✓ Captured \"%s\" in your inbox", taskReq.Title)
+	}
+	fmt.Println()
+}
+
+// processInbox walks through unfinished inbox tasks, letting the user fill
+// in the details skipped at capture time
+func (c *FocusForgeCLI) processInbox() {
+	color.Cyan("🗃️  Process Inbox")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load tasks")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", inboxCategory, c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+
+	var inbox []*Task
+	for _, task := range resp.Tasks {
+		if task.Category == inboxCategory && task.Status != "completed" {
+			inbox = append(inbox, task)
+		}
+	}
+	if len(inbox) == 0 {
+		color.Green("✓ Inbox zero - nothing waiting to be processed")
+		fmt.Println()
+		return
+	}
+
+	processed := 0
+	for i, task := range inbox {
+		color.Cyan("%d/%d: %s", i+1, len(inbox), task.Title)
+
+		prompt := promptui.Select{
+			Label: "What now?",
+			Items: []string{"✏️  Add Details", "⏭️  Skip", "🔙 Stop"},
+		}
+		_, choice, err := prompt.Run()
+		if err != nil || choice == "🔙 Stop" {
+			break
+		}
+		if choice == "⏭️  Skip" {
+			fmt.Println()
+			continue
+		}
+		if c.detailInboxTask(task) {
+			processed++
+		}
+		fmt.Println()
+	}
+
+	color.Green("✓ Processed %d of %d inbox task(s)", processed, len(inbox))
+	fmt.Println()
+}
+
+// detailInboxTask asks for the details of a captured task and files it
+// under a real category. It reports whether the task was updated.
+func (c *FocusForgeCLI) detailInboxTask(task *Task) bool {
+	descPrompt := promptui.Prompt{
+		Label:   "Task Description (optional)",
+		Default: task.Description,
+	}
+	description, err := descPrompt.Run()
+	if err != nil {
+		return false
+	}
+
+	durationPrompt := promptui.Prompt{
+		Label:    "Duration in minutes",
+		Default:  strconv.Itoa(task.DurationMinutes),
+		Validate: validateDuration,
+	}
+	durationStr, err := durationPrompt.Run()
+	if err != nil {
+		return false
+	}
+	duration, _ := strconv.Atoi(strings.TrimSpace(durationStr))
+
+	category, err := c.selectCategory("Task Category", c.config.DefaultCategory)
+	if err != nil {
+		return false
+	}
+
+	priorityPrompt := promptui.Select{
+		Label:     "Task Priority",
+		Items:     taskPriorities,
+		CursorPos: indexOf(taskPriorities, task.Priority),
+	}
+	_, priority, err := priorityPrompt.Run()
+	if err != nil {
+		return false
+	}
+
+	resp, err := c.apiClient.UpdateTask(task.ID, TaskUpdateRequest{
+		Description:     description,
+		DurationMinutes: duration,
+		Category:        category,
+		Priority:        priority,
+	})
+	if err != nil {
+		color.Red("❌ Failed to update task: %v", err)
+		return false
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to update task: %s", errorMessage(resp))
+		return false
+	}
+	color.Green("✓ Filed \"%s\" under %s", task.Title, category)
+	return true
+}
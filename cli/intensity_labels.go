@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// defaultIntensityLabels are the scale anchors used until the user sets
+// their own
+var defaultIntensityLabels = map[int]string{
+	1:  "barely",
+	3:  "mild",
+	5:  "moderate",
+	7:  "strong",
+	10: "overwhelming",
+}
+
+// intensityLabels returns the configured scale anchors, or the defaults if
+// none are set
+func (cfg *Config) intensityLabels() map[int]string {
+	if len(cfg.IntensityLabels) == 0 {
+		return defaultIntensityLabels
+	}
+	return cfg.IntensityLabels
+}
+
+// intensityLabel returns the label of the anchor nearest to intensity,
+// preferring the lower anchor on a tie, or "" if there are no anchors
+func intensityLabel(labels map[int]string, intensity int) string {
+	best, bestDistance := "", 0
+	for anchor, label := range labels {
+		distance := anchor - intensity
+		if distance < 0 {
+			distance = -distance
+		}
+		if best == "" || distance < bestDistance || (distance == bestDistance && anchor < intensity) {
+			best, bestDistance = label, distance
+		}
+	}
+	return best
+}
+
+// formatIntensity renders an intensity as a colored "7/10 (strong)", or "-"
+// if it was not given
+func (c *FocusForgeCLI) formatIntensity(intensity int) string {
+	if intensity <= 0 {
+		return intensityColor(0).Sprint("-")
+	}
+	text := fmt.Sprintf("%d/10", intensity)
+	if label := intensityLabel(c.config.intensityLabels(), intensity); label != "" {
+		text += fmt.Sprintf(" (%s)", label)
+	}
+	return intensityColor(intensity).Sprint(withSymbol(intensitySymbol(intensity), text))
+}
+
+// intensityItems lists the choices for the intensity select, each with its
+// nearest label
+func (c *FocusForgeCLI) intensityItems() []string {
+	labels := c.config.intensityLabels()
+	items := make([]string, 10)
+	for i := range items {
+		items[i] = fmt.Sprintf("%d", i+1)
+		if label := intensityLabel(labels, i+1); label != "" {
+			items[i] += fmt.Sprintf(" (%s)", label)
+		}
+	}
+	return items
+}
+
+func (c *FocusForgeCLI) showIntensityLabelSettings() {
+	for {
+		color.Cyan("🏷️  Intensity Labels")
+		fmt.Println()
+
+		labels := c.config.intensityLabels()
+		anchors := make([]int, 0, len(labels))
+		for anchor := range labels {
+			anchors = append(anchors, anchor)
+		}
+		sort.Ints(anchors)
+		for _, anchor := range anchors {
+			fmt.Printf("  %2d = %s\n", anchor, labels[anchor])
+		}
+		fmt.Println()
+		dimmed.Println("Other levels use the nearest label, e.g. 6/10 shows as \"" + intensityLabel(labels, 6) + "\".")
+		fmt.Println()
+
+		prompt := promptui.Select{
+			Label: "What would you like to do?",
+			Items: []string{"✏️  Set a Label", "🗑️  Remove a Label", "↩️  Reset to Defaults", "🔙 Back"},
+		}
+		_, choice, err := prompt.Run()
+		if err != nil || choice == "🔙 Back" {
+			return
+		}
+
+		// Edit a copy so the defaults are never modified
+		updated := make(map[int]string, len(labels))
+		for anchor, label := range labels {
+			updated[anchor] = label
+		}
+
+		switch choice {
+		case "✏️  Set a Label":
+			level, ok := promptInt("Intensity level", 5, 1, 10)
+			if !ok {
+				continue
+			}
+			labelPrompt := promptui.Prompt{
+				Label:   fmt.Sprintf("Label for %d", level),
+				Default: updated[level],
+				Validate: func(input string) error {
+					if strings.TrimSpace(input) == "" {
+						return fmt.Errorf("label cannot be empty")
+					}
+					return nil
+				},
+			}
+			label, err := labelPrompt.Run()
+			if err != nil {
+				continue
+			}
+			updated[level] = strings.TrimSpace(label)
+		case "🗑️  Remove a Label":
+			if len(anchors) <= 1 {
+				color.Yellow("Keep at least one label, or reset to the defaults.")
+				fmt.Println()
+				continue
+			}
+			items := make([]string, len(anchors))
+			for i, anchor := range anchors {
+				items[i] = fmt.Sprintf("%d = %s", anchor, labels[anchor])
+			}
+			removePrompt := promptui.Select{
+				Label: "Remove which label?",
+				Items: items,
+			}
+			idx, _, err := removePrompt.Run()
+			if err != nil {
+				continue
+			}
+			delete(updated, anchors[idx])
+		case "↩️  Reset to Defaults":
+			updated = nil
+		}
+
+		c.config.IntensityLabels = updated
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Settings saved")
+		}
+		fmt.Println()
+	}
+}
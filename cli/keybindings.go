@@ -0,0 +1,183 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// quickAction is a menu action reachable with a single keypress
+type quickAction struct {
+	Name  string
+	Label string
+	Run   func(c *FocusForgeCLI)
+}
+
+// quickActions lists the actions offered by the quick-action dispatcher, in
+// display order
+var quickActions = []quickAction{
+	{"suggest_next", "🤖 Suggest next", (*FocusForgeCLI).suggestNext},
+	{"new_task", "➕ Create new task", (*FocusForgeCLI).createNewTask},
+	{"quick_capture", "📥 Quick capture", (*FocusForgeCLI).quickCapture},
+	{"list_tasks", "📝 List my tasks", (*FocusForgeCLI).listTasks},
+	{"start_session", "▶️  Start focus session", (*FocusForgeCLI).startFocusSession},
+	{"current_session", "⏱️  Current session", (*FocusForgeCLI).showCurrentSession},
+	{"log_distraction", "📵 Log distraction", (*FocusForgeCLI).logDistraction},
+	{"quick_mood", "⚡ Quick mood", (*FocusForgeCLI).showQuickMood},
+	{"log_mood", "😊 Log mood", (*FocusForgeCLI).logMood},
+}
+
+// defaultKeybindings maps each quick action to its default key
+var defaultKeybindings = map[string]string{
+	"suggest_next":    "n",
+	"new_task":        "c",
+	"quick_capture":   "i",
+	"list_tasks":      "l",
+	"start_session":   "s",
+	"current_session": "t",
+	"log_distraction": "d",
+	"quick_mood":      "m",
+	"log_mood":        "o",
+	"command_palette": "/",
+}
+
+// keybindings returns the effective key for every quick action: the
+// configured key where one is set, the default otherwise
+func (cfg *Config) keybindings() map[string]string {
+	bindings := make(map[string]string, len(defaultKeybindings))
+	for action, key := range defaultKeybindings {
+		bindings[action] = key
+	}
+	for action, key := range cfg.Keybindings {
+		if _, ok := defaultKeybindings[action]; ok {
+			bindings[action] = key
+		}
+	}
+	return bindings
+}
+
+// validateKey checks that key is a single printable, non-space character
+func validateKey(key string) error {
+	runes := []rune(key)
+	if len(runes) != 1 || runes[0] > unicode.MaxASCII || !unicode.IsPrint(runes[0]) || unicode.IsSpace(runes[0]) {
+		return fmt.Errorf("key must be a single printable character")
+	}
+	return nil
+}
+
+// validateKeybindings rejects invalid keys and keys bound to more than one
+// action
+func validateKeybindings(bindings map[string]string) error {
+	actions := make([]string, 0, len(bindings))
+	for action := range bindings {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	owner := map[string]string{}
+	for _, action := range actions {
+		key := strings.ToLower(bindings[action])
+		if err := validateKey(key); err != nil {
+			return fmt.Errorf("%s: %v", action, err)
+		}
+		if other, ok := owner[key]; ok {
+			return fmt.Errorf("key %q is bound to both %s and %s", key, other, action)
+		}
+		owner[key] = action
+	}
+	return nil
+}
+
+// showQuickActions lists the bound keys and runs the action for the next
+// keypress
+func (c *FocusForgeCLI) showQuickActions() {
+	color.Cyan("⌨️  Quick Actions")
+	fmt.Println()
+
+	bindings := c.config.keybindings()
+	var actions []quickAction
+	for _, action := range quickActions {
+		if feature, ok := quickActionFeatures[action.Name]; ok && !c.featureEnabled(feature) {
+			continue
+		}
+		actions = append(actions, action)
+		fmt.Printf("  [%s] %s\n", bindings[action.Name], action.Label)
+	}
+	fmt.Println()
+	fmt.Print("Press a key (any other key goes back): ")
+
+	key, err := readKey()
+	fmt.Println()
+	if err != nil {
+		color.Red("Error reading key: %v", err)
+		return
+	}
+
+	pressed := strings.ToLower(string(rune(key)))
+	for _, action := range actions {
+		if bindings[action.Name] == pressed {
+			fmt.Println()
+			action.Run(c)
+			return
+		}
+	}
+}
+
+func (c *FocusForgeCLI) showKeybindingSettings() {
+	color.Cyan("⌨️  Keybindings")
+	fmt.Println()
+
+	for {
+		bindings := c.config.keybindings()
+
+		items := make([]string, 0, len(quickActions)+2)
+		for _, action := range quickActions {
+			items = append(items, fmt.Sprintf("[%s] %s", bindings[action.Name], action.Label))
+		}
+		items = append(items, "↩️  Reset to defaults", "✓ Done")
+
+		prompt := promptui.Select{
+			Label: "Select an action to rebind",
+			Items: items,
+			Size:  10,
+		}
+		idx, _, err := prompt.Run()
+		if err != nil || idx == len(items)-1 {
+			fmt.Println()
+			return
+		}
+
+		if idx == len(quickActions) {
+			c.config.Keybindings = nil
+		} else {
+			action := quickActions[idx]
+			keyPrompt := promptui.Prompt{
+				Label:    fmt.Sprintf("New key for %s", menuLabel(action.Label)),
+				Default:  bindings[action.Name],
+				Validate: validateKey,
+			}
+			key, err := keyPrompt.Run()
+			if err != nil {
+				continue
+			}
+
+			updated := c.config.keybindings()
+			updated[action.Name] = strings.ToLower(key)
+			if err := validateKeybindings(updated); err != nil {
+				color.Red("❌ %v", err)
+				continue
+			}
+			c.config.Keybindings = updated
+		}
+
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Settings saved")
+		}
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !darwin && !linux
+
+package main
+
+import "time"
+
+func readKeyWithin(d time.Duration) (byte, bool, error) {
+	return 0, false, errKeyWaitUnsupported
+}
@@ -0,0 +1,45 @@
+//go:build darwin || linux
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/chzyer/readline"
+	"golang.org/x/sys/unix"
+)
+
+// readKeyWithin waits up to d for a keypress, returning the key and whether
+// one was pressed in time
+func readKeyWithin(d time.Duration) (byte, bool, error) {
+	fd := int(os.Stdin.Fd())
+	if !readline.IsTerminal(fd) {
+		return 0, false, errKeyWaitUnsupported
+	}
+
+	state, err := readline.MakeRaw(fd)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read key: %v", err)
+	}
+	defer readline.Restore(fd, state)
+
+	fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+	n, err := unix.Poll(fds, int(d.Milliseconds()))
+	if err == unix.EINTR {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read key: %v", err)
+	}
+	if n == 0 {
+		return 0, false, nil
+	}
+
+	buf := make([]byte, 1)
+	if _, err := os.Stdin.Read(buf); err != nil {
+		return 0, false, fmt.Errorf("failed to read key: %v", err)
+	}
+	return buf[0], true, nil
+}
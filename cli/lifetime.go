@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+func (c *FocusForgeCLI) showLifetimeStats() {
+	color.Cyan("🏅 All-Time Stats")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load your stats")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetLifetimeStats()
+	if err != nil {
+		color.Red("❌ Failed to fetch all-time stats: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !resp.Success || resp.Stats == nil {
+		color.Red("❌ Failed to fetch all-time stats: %s", errorMessage(resp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	stats := resp.Stats
+
+	if stats.TotalFocusMinutes == 0 && stats.TasksCompleted == 0 && stats.MoodsLogged == 0 {
+		printBox(color.New(color.FgCyan), []string{
+			"🌱 Your journey starts here!",
+			"",
+			"Finish a focus session, complete a task or log a mood",
+			"and your all-time stats will start growing.",
+		}, roundedBox, 40, true)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	lines := []string{"🏅 Since you started 🏅", ""}
+	if joined, ok := parseTimestamp(stats.JoinedAt); ok {
+		lines = append(lines, fmt.Sprintf("📅 Member since %s", c.formatTime(joined, "Jan 2, 2006")))
+	}
+	lines = append(lines,
+		fmt.Sprintf("⏱️  %.1f hours of focus", float64(stats.TotalFocusMinutes)/60),
+		fmt.Sprintf("🎯 %d sessions finished", stats.SessionsCompleted),
+		fmt.Sprintf("✅ %d tasks completed", stats.TasksCompleted),
+		fmt.Sprintf("🔥 %d-day longest streak", stats.LongestStreak),
+		fmt.Sprintf("😊 %d moods logged", stats.MoodsLogged),
+	)
+	printBox(color.New(color.FgYellow, color.Bold), lines, doubleBox, 40, true)
+	fmt.Println()
+	waitForEnter()
+}
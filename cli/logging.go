@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// maxLogSize is how large the log file may grow before it is rotated to
+// focusforge.log.1, replacing any previous rotation
+const maxLogSize = 1 << 20
+
+// logLevels lists the log levels offered in settings, most verbose first
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+// Logging is configured by swapping the output and level underneath appLog,
+// so goroutines that log concurrently never see a half-updated logger
+var (
+	logLevel  = new(slog.LevelVar)
+	logOutput = &switchWriter{}
+
+	// appLog receives troubleshooting logs. They are discarded unless file
+	// logging is enabled.
+	appLog = slog.New(slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: logLevel, ReplaceAttr: redactSecrets}))
+)
+
+// switchWriter forwards writes to a replaceable writer, dropping them while
+// none is set
+type switchWriter struct {
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+func (s *switchWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.w == nil {
+		return len(p), nil
+	}
+	return s.w.Write(p)
+}
+
+// set replaces the writer, closing the previous one
+func (s *switchWriter) set(w io.WriteCloser) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.w != nil {
+		s.w.Close()
+	}
+	s.w = w
+}
+
+// logPath returns the location of the log file
+func logPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "focusforge.log"), nil
+}
+
+// parseLogLevel maps a level name to a slog level, defaulting to info
+func parseLogLevel(name string) slog.Level {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// setupLogging sends appLog to the log file according to cfg, or discards
+// logs if file logging is off
+func setupLogging(cfg *Config) error {
+	if !cfg.FileLogging {
+		closeLogging()
+		return nil
+	}
+
+	path, err := logPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create log directory: %v", err)
+	}
+	file, err := openRotatingFile(path, maxLogSize)
+	if err != nil {
+		return err
+	}
+
+	logLevel.Set(parseLogLevel(cfg.LogLevel))
+	logOutput.set(file)
+	return nil
+}
+
+// closeLogging closes the log file, if any, and discards further logs
+func closeLogging() {
+	logOutput.set(nil)
+}
+
+// rotatingFile is a log file that is moved aside to path.1 once it would
+// grow past maxSize
+type rotatingFile struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+func openRotatingFile(path string, maxSize int64) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, maxSize: maxSize}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to open log file: %v", err)
+	}
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return 0, os.ErrClosed
+	}
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		r.file.Close()
+		r.file = nil
+		if err := os.Rename(r.path, r.path+".1"); err != nil {
+			return 0, fmt.Errorf("failed to rotate log file: %v", err)
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+func (c *FocusForgeCLI) showLoggingSettings() {
+	color.Cyan("📝 Debug Logging")
+	fmt.Println()
+
+	path, _ := logPath()
+	fmt.Printf("Log file: %s\n", path)
+	fmt.Printf("File logging: %s\n", onOff(c.config.FileLogging))
+	fmt.Printf("Log level: %s\n", c.config.LogLevel)
+	fmt.Println()
+
+	enablePrompt := promptui.Select{
+		Label: "Write a debug log you can attach to bug reports?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := enablePrompt.Run()
+	if err != nil {
+		return
+	}
+	c.config.FileLogging = choice == "Yes"
+
+	if c.config.FileLogging {
+		levelPrompt := promptui.Select{
+			Label:     "Log level",
+			Items:     logLevels,
+			CursorPos: indexOf(logLevels, c.config.LogLevel),
+		}
+		if _, level, err := levelPrompt.Run(); err == nil {
+			c.config.LogLevel = level
+		}
+	}
+
+	if err := setupLogging(c.config); err != nil {
+		color.Yellow("⚠️  Could not start file logging: %v", err)
+	}
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+}
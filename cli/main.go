@@ -2,11 +2,16 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"strconv"
+	"sync"
 	"time"
+	"unicode"
 
 	"github.com/fatih/color"
 	"github.com/manifoldco/promptui"
@@ -15,26 +20,185 @@ import (
 type FocusForgeCLI struct {
 	apiURL    string
 	userID    string
-	isRunning bool
+	token     string
 	apiClient *APIClient
+	config    *Config
+	location  *time.Location
+
+	// sources records where apiURL, userID and token came from
+	sources map[string]string
+
+	// timeout is the per-request timeout from --timeout
+	timeout time.Duration
+
+	// defaultDuration pre-fills the duration of new tasks, from --duration
+	// or FOCUSFORGE_DURATION; empty leaves the prompt blank
+	defaultDuration string
+
+	// lastAction is the most recent action that can be repeated
+	lastAction *repeatableAction
+
+	// statusFilter is the statuses the task list is limited to this run;
+	// empty shows every status
+	statusFilter []string
+
+	// metrics collects request stats when enabled; it outlives API clients
+	// so switching backends keeps the numbers
+	metrics *clientMetrics
+
+	// features caches the backend's feature flags, fetched once at startup.
+	// Like apiClient it is only replaced under mu.
+	features map[string]bool
+
+	// configBroken is set when the config file could not be loaded, so
+	// shutdown doesn't overwrite it with defaults
+	configBroken bool
+
+	// ctx is cancelled on shutdown to stop the goroutines in background
+	ctx          context.Context
+	cancel       context.CancelFunc
+	background   sync.WaitGroup
+	shutdownOnce sync.Once
+
+	// flushMu serializes flushes of the offline queue, which the health
+	// checker may start in the background on reconnect. queueMu guards the
+	// queue file itself and is only held while it is read or written, so
+	// changes can still be queued while a flush waits on the network.
+	flushMu sync.Mutex
+	queueMu sync.Mutex
+
+	// mu guards the state below, which background goroutines such as the
+	// session timer may read while the menus are running
+	mu            sync.Mutex
+	isRunning     bool
+	connected     bool
+	activeSession *focusSession
+	updateNotice  string
+}
+
+// running reports whether the main loop should keep going
+func (c *FocusForgeCLI) running() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.isRunning
+}
+
+// stopRunning makes the main loop exit after the current menu
+func (c *FocusForgeCLI) stopRunning() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.isRunning = false
+}
+
+// isConnected reports whether the backend answered the last health check
+func (c *FocusForgeCLI) isConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.connected
+}
+
+// setConnected records the result of a health check
+func (c *FocusForgeCLI) setConnected(connected bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = connected
+}
+
+// client returns the current API client. Background goroutines must use it
+// instead of reading apiClient, which importing settings replaces.
+func (c *FocusForgeCLI) client() *APIClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.apiClient
+}
+
+// setClient switches to a new API client, forgetting the old backend's
+// feature flags
+func (c *FocusForgeCLI) setClient(client *APIClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.apiClient = client
+	c.features = nil
+}
+
+// session returns the active focus session, or nil if there is none
+func (c *FocusForgeCLI) session() *focusSession {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.activeSession
+}
+
+// setSession replaces the active focus session; pass nil to clear it
+func (c *FocusForgeCLI) setSession(s *focusSession) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.activeSession = s
 }
 
 func main() {
+	apiURLFlag := flag.String("api-url", "", "FocusForge API URL (overrides "+envAPIURL+" and config)")
+	userIDFlag := flag.String("user-id", "", "User ID (overrides "+envUserID+" and config)")
+	tokenFlag := flag.String("token", "", "API token (overrides "+envToken+" and config)")
+	timeoutFlag := flag.Duration("timeout", defaultRequestTimeout, "How long to wait for regular API requests (AI requests wait at least "+aiRequestTimeout.String()+")")
+	durationFlag := flag.String("duration", "", "Default duration in minutes for new tasks (overrides "+envDuration+")")
+	flag.Parse()
+
+	// A bad duration from a script should stop it rather than be ignored
+	defaultDuration, durationSource := resolveSetting(*durationFlag, envDuration, "", "")
+	if defaultDuration != "" {
+		if err := validateDuration(defaultDuration); err != nil {
+			color.Red("❌ Invalid duration %q from %s: %v", defaultDuration, durationSource, err)
+			os.Exit(2)
+		}
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		color.Yellow("⚠️  Warning: %v - using default settings", err)
+	}
+	if err := validateKeybindings(config.keybindings()); err != nil {
+		color.Yellow("⚠️  Warning: invalid keybindings (%v) - using default keys", err)
+		config.Keybindings = nil
+	}
+
 	cli := &FocusForgeCLI{
-		apiURL:    "http://localhost:8000",
-		userID:    "",
-		isRunning: true,
-		apiClient: nil,
+		isRunning:    true,
+		apiClient:    nil,
+		config:       config,
+		sources:      map[string]string{},
+		metrics:      newClientMetrics(),
+		configBroken: err != nil,
+
+		defaultDuration: strings.TrimSpace(defaultDuration),
+	}
+	if err := setupLogging(config); err != nil {
+		color.Yellow("⚠️  Warning: %v - file logging disabled", err)
 	}
+	appLog.Info("starting")
+	cli.ctx, cli.cancel = context.WithCancel(context.Background())
+	cli.handleSignals()
+	enableEscBack()
+	cli.apiURL, cli.sources["api_url"] = resolveSetting(*apiURLFlag, envAPIURL, config.APIURL, defaultConfig().APIURL)
+	cli.userID, cli.sources["user_id"] = resolveSetting(*userIDFlag, envUserID, config.UserID, "")
+	cli.token, cli.sources["token"] = resolveSetting(*tokenFlag, envToken, config.Token, "")
+	if *timeoutFlag <= 0 {
+		color.Yellow("⚠️  Warning: --timeout must be positive - using %s", defaultRequestTimeout)
+		*timeoutFlag = defaultRequestTimeout
+	}
+	cli.timeout = *timeoutFlag
+	cli.applyTimezone()
+	cli.applyTheme()
 
 	// Show welcome message
 	cli.showWelcome()
 
 	// Initialize API client
-	cli.apiClient = NewAPIClient(cli.apiURL, cli.userID)
+	cli.apiClient = cli.newAPIClient()
 
 	// Check API health
-	if err := cli.apiClient.HealthCheck(); err != nil {
+	err = cli.apiClient.HealthCheck()
+	cli.setConnected(err == nil)
+	if err != nil {
 		color.Yellow("⚠️  Warning: Could not connect to FocusForge backend")
 		color.Yellow("   Make sure the backend is running at: %s", cli.apiURL)
 		color.Yellow("   Some features may not work properly")
@@ -44,36 +208,102 @@ func main() {
 		fmt.Println()
 	}
 
+	if cli.isConnected() {
+		cli.loadFeatures()
+		if pendingSync() > 0 {
+			cli.syncNow()
+		}
+	}
+	cli.endExpiredVacation()
+	if cli.isConnected() && !cli.onVacation(time.Now()) {
+		cli.noteMissedCommitment()
+	}
+
+	// Offer to pick up a session interrupted by a crash or kill
+	cli.recoverSession()
+
+	// Keep the connection status current while the menus are in use
+	cli.goBackground(cli.runHealthChecker)
+	if config.UpdateCheck {
+		cli.goBackground(cli.checkForUpdate)
+	}
+
 	// Main menu loop
-	for cli.isRunning {
+	for cli.running() {
 		cli.showMainMenu()
 	}
+	
+	cli.shutdown()
 }
 
 func (c *FocusForgeCLI) showWelcome() {
-	color.Cyan("╔══════════════════════════════════════════════════════════════╗")
-	color.Cyan("║                    🚀 FocusForge CLI 🚀                    ║")
-	color.Cyan("║              Your AI-Powered Productivity Assistant         ║")
-	color.Cyan("╚══════════════════════════════════════════════════════════════╝")
-	fmt.Println()
+	if c.config.ShowBanner {
+		banner := []string{"🚀 FocusForge CLI 🚀", "Your AI-Powered Productivity Assistant"}
+		minWidth := 62
+		if isNarrow() {
+			banner, minWidth = banner[:1], 0
+		}
+		printBox(color.New(color.FgCyan), banner, doubleBox, minWidth, true)
+		fmt.Println()
+		
+		color.Yellow("Welcome to FocusForge! Let's get you set up for maximum productivity.")
+		fmt.Println()
+	}
 	
-	color.Yellow("Welcome to FocusForge! Let's get you set up for maximum productivity.")
-	fmt.Println()
+	if !c.config.Onboarded {
+		c.runOnboarding()
+		return
+	}
+	
+	// A user ID from a flag or the environment is used as-is
+	if c.overridden("user_id") {
+		color.Green("✓ User ID set to: %s (from %s)", c.userID, c.sources["user_id"])
+		fmt.Println()
+		return
+	}
 	
 	// Get user ID
 	c.getUserID()
 }
 
+// overridden reports whether a setting was supplied by a flag or the
+// environment, in which case it must not be replaced by prompts or config
+func (c *FocusForgeCLI) overridden(setting string) bool {
+	source := c.sources[setting]
+	return source == sourceFlag || source == sourceEnv
+}
+
+// newAPIClient builds an API client from the effective settings
+func (c *FocusForgeCLI) newAPIClient() *APIClient {
+	client := NewAPIClient(c.apiURL, c.userID)
+	client.SetToken(c.token)
+	if c.timeout > 0 {
+		client.SetTimeout(c.timeout)
+	}
+	client.SetRateLimit(c.config.RateLimit)
+	client.SetRetryPolicy(c.config.retryPolicy())
+	client.SetEndpointOverrides(c.endpointOverrides())
+	if c.config.Metrics {
+		client.SetMetrics(c.metrics)
+	}
+	return client
+}
+
 func (c *FocusForgeCLI) getUserID() {
+	defaultUserID := "default"
+	if c.config.UserID != "" {
+		defaultUserID = c.config.UserID
+	}
+	
 	prompt := promptui.Prompt{
-		Label: "Enter your User ID (or press Enter for 'default')",
-		Default: "default",
+		Label: fmt.Sprintf("Enter your User ID (or press Enter for '%s')", defaultUserID),
+		Default: defaultUserID,
 	}
 	
 	userID, err := prompt.Run()
 	if err != nil {
 		color.Red("Error getting user ID: %v", err)
-		c.userID = "default"
+		c.userID = defaultUserID
 	} else {
 		c.userID = userID
 	}
@@ -83,7 +313,37 @@ func (c *FocusForgeCLI) getUserID() {
 }
 
 func (c *FocusForgeCLI) showMainMenu() {
+	if c.config.ClearScreen {
+		clearScreen()
+	}
+	
+	if !c.isConnected() {
+		color.Yellow("⚠️  Offline - will reconnect to the backend automatically")
+	}
+	if c.onVacation(time.Now()) {
+		color.Cyan("%s", c.vacationBanner())
+	}
+	if notice := c.pendingUpdateNotice(); notice != "" {
+		color.Cyan("%s", notice)
+	}
+	pending := pendingSync()
+	if pending > 0 {
+		color.Yellow("📥 %d change(s) waiting to sync", pending)
+	}
+	
+	// Strict focus keeps the user on the session screen until it ends
+	if c.focusLocked() {
+		color.Yellow("🔒 Strict focus is on - the menu unlocks when this session ends")
+		fmt.Println()
+		c.showCurrentSession()
+		return
+	}
+	
 	menuItems := []string{
+		"🤖 Suggest Next",
+		"🔎 Command Palette",
+		"⌨️  Quick Actions",
+		quickCaptureLabel,
 		"📋 Task Management",
 		"🎯 Focus Sessions",
 		"😊 Mood Tracking",
@@ -93,66 +353,207 @@ func (c *FocusForgeCLI) showMainMenu() {
 		"⚙️  Settings",
 		"❌ Exit",
 	}
+	if repeat := c.repeatMenuItem(); repeat != "" {
+		menuItems = append([]string{repeat}, menuItems...)
+	}
+	if pending > 0 {
+		menuItems = append([]string{fmt.Sprintf("%s (%d pending)", syncLabel, pending)}, menuItems...)
+	}
 	
 	prompt := promptui.Select{
 		Label: "What would you like to do?",
-		Items: menuItems,
+		Items: c.availableItems(menuItems),
 		Size:  10,
 	}
 	
 	_, result, err := prompt.Run()
+	if wentBack(err) {
+		// Already at the top; Esc never quits, use Exit for that
+		return
+	}
 	if err != nil {
 		color.Red("Error selecting menu item: %v", err)
 		return
 	}
 	
+	if strings.HasPrefix(result, repeatLabel) {
+		c.repeatLast()
+		return
+	}
+	if strings.HasPrefix(result, syncLabel) {
+		c.syncNow()
+		return
+	}
+	
 	switch result {
+	case "🤖 Suggest Next":
+		c.suggestNext()
+	case "🔎 Command Palette":
+		c.showCommandPalette()
+	case "⌨️  Quick Actions":
+		c.showQuickActions()
+	case quickCaptureLabel:
+		c.quickCapture()
 	case "📋 Task Management":
-		c.showTaskManagement()
+		c.showTaskManagement("Main")
 	case "🎯 Focus Sessions":
-		c.showFocusSessions()
+		c.showFocusSessions("Main")
 	case "😊 Mood Tracking":
-		c.showMoodTracking()
+		c.showMoodTracking("Main")
 	case "🏆 Gamification & Rewards":
-		c.showGamification()
+		c.showGamification("Main")
 	case "📊 Analytics & Insights":
-		c.showAnalytics()
+		c.showAnalytics("Main")
 	case "🎵 Spotify Integration":
 		c.showSpotifyIntegration()
 	case "⚙️  Settings":
-		c.showSettings()
+		c.showSettings("Main")
 	case "❌ Exit":
 		c.exit()
 	}
 }
 
-func (c *FocusForgeCLI) showTaskManagement() {
+func (c *FocusForgeCLI) suggestNext() {
+	color.Cyan("🤖 What Should I Do Next?")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot fetch a suggestion")
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	stop := startSpinner("Asking the AI what to focus on next...")
+	resp, err := c.apiClient.GetNextSuggestion()
+	stop()
+
+	var suggestion *Suggestion
+	if err != nil {
+		color.Yellow("⚠️  AI suggestions are unavailable right now: %v", err)
+	} else if !resp.Success || resp.Suggestion == nil {
+		color.Yellow("⚠️  AI suggestions are unavailable right now: %s", errorMessage(resp))
+	} else {
+		suggestion = resp.Suggestion
+	}
+
+	// Degrade to a simple local pick so the user still gets a next step
+	if suggestion == nil {
+		suggestion = c.fallbackSuggestion()
+		if suggestion == nil {
+			color.Yellow("No pending tasks found. Create a task to get started!")
+			fmt.Println()
+			waitForEnter()
+			return
+		}
+		color.Yellow("Falling back to your highest-priority pending task.")
+	}
+
+	fmt.Println()
+	color.Green("👉 %s", suggestion.Action)
+	if suggestion.TaskTitle != "" {
+		fmt.Printf("  Task: %s\n", suggestion.TaskTitle)
+	}
+	if suggestion.SuggestedMinutes > 0 {
+		fmt.Printf("  Suggested Duration: %d minutes\n", suggestion.SuggestedMinutes)
+	}
+	if suggestion.Reasoning != "" {
+		fmt.Println()
+		color.Cyan("Why:")
+		fmt.Printf("  %s\n", suggestion.Reasoning)
+	}
+	fmt.Println()
+
+	if suggestion.TaskID == "" {
+		waitForEnter()
+		return
+	}
+
+	startPrompt := promptui.Select{
+		Label: "Start a focus session on this task?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := startPrompt.Run()
+	if err != nil || choice != "Yes" {
+		return
+	}
+
+	task := &Task{
+		ID:              suggestion.TaskID,
+		Title:           suggestion.TaskTitle,
+		DurationMinutes: suggestion.SuggestedMinutes,
+	}
+	c.startSessionOnTask(task)
+}
+
+// fallbackSuggestion picks the highest-priority pending task when the AI
+// service cannot be reached. It returns nil if there is nothing to suggest.
+func (c *FocusForgeCLI) fallbackSuggestion() *Suggestion {
+	resp, err := c.apiClient.GetTasks("pending", "", c.config.listLimit())
+	if err != nil || !resp.Success || len(resp.Tasks) == 0 {
+		return nil
+	}
+
+	best := resp.Tasks[0]
+	for _, task := range resp.Tasks[1:] {
+		if priorityRank[task.Priority] > priorityRank[best.Priority] {
+			best = task
+		}
+	}
+
+	return &Suggestion{
+		TaskID:           best.ID,
+		TaskTitle:        best.Title,
+		Action:           fmt.Sprintf("Work on \"%s\"", best.Title),
+		SuggestedMinutes: best.DurationMinutes,
+	}
+}
+
+func (c *FocusForgeCLI) showTaskManagement(parent string) {
+	path := crumb(parent, "Tasks")
 	for {
+		renderHeader(path)
+		
 		menuItems := []string{
 			"➕ Create New Task",
+			"📑 Create from Template",
 			"📝 List My Tasks",
 			"🔍 View Task Details",
 			"✏️  Edit Task",
 			"🗑️  Delete Task",
+			"😴 Snooze Task",
+			"📆 Due Soon",
+			"🗃️  Process Inbox",
+			"↪️  Carry Over",
+			"📆 Recurring Tasks",
 			"📊 Task Dashboard",
 			"🔙 Back to Main Menu",
 		}
 		
 		prompt := promptui.Select{
-			Label: "Task Management - What would you like to do?",
-			Items: menuItems,
+			Label: "What would you like to do?",
+			Items: c.availableItems(menuItems),
 			Size:  10,
 		}
 		
 		_, result, err := prompt.Run()
+		if wentBack(err) {
+			return
+		}
 		if err != nil {
 			color.Red("Error selecting menu item: %v", err)
 			return
 		}
 		
+		if !isBackItem(result) {
+			renderHeader(crumb(path, menuLabel(result)))
+		}
+		
 		switch result {
 		case "➕ Create New Task":
 			c.createNewTask()
+		case "📑 Create from Template":
+			c.showTemplates()
 		case "📝 List My Tasks":
 			c.listTasks()
 		case "🔍 View Task Details":
@@ -161,6 +562,16 @@ func (c *FocusForgeCLI) showTaskManagement() {
 			c.editTask()
 		case "🗑️  Delete Task":
 			c.deleteTask()
+		case "😴 Snooze Task":
+			c.snoozeTask()
+		case "📆 Due Soon":
+			c.showDueSoon()
+		case "🗃️  Process Inbox":
+			c.processInbox()
+		case "↪️  Carry Over":
+			c.carryOverTasks()
+		case "📆 Recurring Tasks":
+			c.showRecurrences()
 		case "📊 Task Dashboard":
 			c.showTaskDashboard()
 		case "🔙 Back to Main Menu":
@@ -169,6 +580,35 @@ func (c *FocusForgeCLI) showTaskManagement() {
 	}
 }
 
+const (
+	minDurationMinutes = 1
+	maxDurationMinutes = 1440
+)
+
+// validateDuration checks that input is a whole number of minutes within
+// [minDurationMinutes, maxDurationMinutes]. It is used directly as a promptui
+// Validate func so bad input is rejected as it is typed.
+func validateDuration(input string) error {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return fmt.Errorf("duration cannot be empty")
+	}
+	minutes, err := strconv.Atoi(input)
+	if err != nil {
+		return fmt.Errorf("duration must be a whole number of minutes")
+	}
+	if !durationInRange(minutes) {
+		return fmt.Errorf("duration must be between %d and %d minutes", minDurationMinutes, maxDurationMinutes)
+	}
+	return nil
+}
+
+// durationInRange reports whether minutes is within the bounds validateDuration
+// enforces
+func durationInRange(minutes int) bool {
+	return minutes >= minDurationMinutes && minutes <= maxDurationMinutes
+}
+
 func (c *FocusForgeCLI) createNewTask() {
 	color.Cyan("🎯 Creating New Task")
 	fmt.Println()
@@ -188,6 +628,9 @@ func (c *FocusForgeCLI) createNewTask() {
 		color.Red("Error getting task title: %v", err)
 		return
 	}
+	if !c.confirmDuplicateTitle(title) {
+		return
+	}
 	
 	// Get task description
 	descPrompt := promptui.Prompt{
@@ -197,13 +640,9 @@ func (c *FocusForgeCLI) createNewTask() {
 	
 	// Get duration
 	durationPrompt := promptui.Prompt{
-		Label: "Duration in minutes",
-		Validate: func(input string) error {
-			if len(strings.TrimSpace(input)) == 0 {
-				return fmt.Errorf("duration cannot be empty")
-			}
-			return nil
-		},
+		Label:    "Duration in minutes",
+		Default:  c.defaultDuration,
+		Validate: validateDuration,
 	}
 	durationStr, err := durationPrompt.Run()
 	if err != nil {
@@ -212,18 +651,14 @@ func (c *FocusForgeCLI) createNewTask() {
 	}
 	
 	// Convert duration to int
-	duration, err := strconv.Atoi(durationStr)
+	duration, err := strconv.Atoi(strings.TrimSpace(durationStr))
 	if err != nil {
 		color.Red("Error parsing duration: %v", err)
 		return
 	}
 	
 	// Get category
-	categoryPrompt := promptui.Select{
-		Label: "Task Category",
-		Items: []string{"work", "personal", "learning", "health", "other"},
-	}
-	_, category, err := categoryPrompt.Run()
+	category, err := c.selectCategory("Task Category", c.config.DefaultCategory)
 	if err != nil {
 		color.Red("Error getting category: %v", err)
 		return
@@ -232,7 +667,7 @@ func (c *FocusForgeCLI) createNewTask() {
 	// Get priority
 	priorityPrompt := promptui.Select{
 		Label: "Task Priority",
-		Items: []string{"low", "medium", "high", "urgent"},
+		Items: taskPriorities,
 	}
 	_, priority, err := priorityPrompt.Run()
 	if err != nil {
@@ -240,6 +675,20 @@ func (c *FocusForgeCLI) createNewTask() {
 		return
 	}
 	
+	// Get effort
+	effort, err := selectEffort()
+	if err != nil {
+		color.Red("Error getting effort: %v", err)
+		return
+	}
+	
+	// Get color label
+	colorLabel, err := selectColorLabel()
+	if err != nil {
+		color.Red("Error getting color label: %v", err)
+		return
+	}
+	
 	// Auto-breakdown option
 	breakdownPrompt := promptui.Select{
 		Label: "Use AI to break down task into blocks?",
@@ -253,7 +702,27 @@ func (c *FocusForgeCLI) createNewTask() {
 	
 	autoBreakdown := breakdownChoice == "Yes"
 	
-	color.Yellow("Creating task...")
+	// Optional prerequisites from the user's open tasks
+	var dependsOn []string
+	if c.apiClient != nil {
+		if resp, err := c.apiClient.GetTasks("", "", c.config.listLimit()); err == nil && resp.Success {
+			var open []*Task
+			for _, task := range resp.Tasks {
+				if task.Status != "completed" {
+					open = append(open, task)
+				}
+			}
+			if len(open) > 0 {
+				dependsPrompt := promptui.Select{
+					Label: "Does this task depend on other tasks?",
+					Items: []string{"No", "Yes"},
+				}
+				if _, choice, err := dependsPrompt.Run(); err == nil && choice == "Yes" {
+					dependsOn = selectPrerequisites(open)
+				}
+			}
+		}
+	}
 	
 	// Create task request
 	taskReq := TaskCreateRequest{
@@ -262,13 +731,44 @@ func (c *FocusForgeCLI) createNewTask() {
 		DurationMinutes: duration,
 		Category:        category,
 		Priority:        priority,
+		DependsOn:       dependsOn,
+		ColorLabel:      colorLabel,
+		EffortPoints:    effort,
+		// Retries below reuse this key so the backend can drop duplicates
+		IdempotencyKey:  newIdempotencyKey(),
+	}
+	
+	fmt.Println()
+	if !c.reviewTask(&taskReq, fmt.Sprintf("AI Breakdown: %t", autoBreakdown)) {
+		waitForEnter()
+		return
 	}
 	
+	color.Yellow("Creating task...")
+	
 	// Make API call to create task
 	if c.apiClient != nil {
-		resp, err := c.apiClient.CreateTask(taskReq)
+		var resp *TaskResponse
+		err := withRetryPrompt(func() error {
+			var err error
+			resp, err = c.apiClient.CreateTask(taskReq)
+			return err
+		})
+		// Let the user correct just the fields the backend rejected
+		for verr := asValidationError(err); verr != nil && c.fixTaskFields(&taskReq, verr); verr = asValidationError(err) {
+			color.Yellow("Creating task...")
+			taskReq.IdempotencyKey = newIdempotencyKey()
+			err = withRetryPrompt(func() error {
+				var err error
+				resp, err = c.apiClient.CreateTask(taskReq)
+				return err
+			})
+		}
 		if err != nil {
 			color.Red("❌ Failed to create task: %v", err)
+			if offline(err) {
+				c.queueOffline(queuedMutation{Kind: mutationCreateTask, Task: &taskReq, IdempotencyKey: taskReq.IdempotencyKey})
+			}
 			fmt.Println()
 			fmt.Println("Press Enter to continue...")
 			bufio.NewReader(os.Stdin).ReadString('\n')
@@ -276,32 +776,40 @@ func (c *FocusForgeCLI) createNewTask() {
 		}
 		
 		if resp.Success {
+			appLog.Info("task created", "category", taskReq.Category, "priority", taskReq.Priority, "duration", taskReq.DurationMinutes)
+			c.rememberAction(fmt.Sprintf("Create a task like \"%s\"", taskReq.Title), false, func() { c.replayTask(taskReq) })
 			color.Green("✓ Task created successfully!")
 			fmt.Println()
 			color.Cyan("Task Details:")
 			if resp.Task != nil {
 				fmt.Printf("  ID: %s\n", resp.Task.ID)
-				fmt.Printf("  Title: %s\n", resp.Task.Title)
+				fmt.Printf("  Title: %s\n", labeledTitle(resp.Task, 0))
 				fmt.Printf("  Description: %s\n", resp.Task.Description)
 				fmt.Printf("  Duration: %d minutes\n", resp.Task.DurationMinutes)
 				fmt.Printf("  Category: %s\n", resp.Task.Category)
-				fmt.Printf("  Priority: %s\n", resp.Task.Priority)
-				fmt.Printf("  Status: %s\n", resp.Task.Status)
+				fmt.Printf("  Priority: %s\n", formatPriority(resp.Task.Priority))
+				if resp.Task.EffortPoints > 0 {
+					fmt.Printf("  Effort: %d pts\n", resp.Task.EffortPoints)
+				}
+				fmt.Printf("  Status: %s\n", formatStatus(resp.Task.Status))
+				if len(resp.Task.DependsOn) > 0 {
+					fmt.Printf("  Depends On: %d task(s)\n", len(resp.Task.DependsOn))
+				}
 			}
 			fmt.Printf("  AI Breakdown: %t\n", autoBreakdown)
 		} else {
-			color.Red("❌ Failed to create task: %s", resp.Error)
+			color.Red("❌ Failed to create task: %s", errorMessage(resp))
 		}
 	} else {
 		color.Yellow("⚠️  API client not available - using mock data")
 		color.Green("✓ Task created successfully! (mock)")
 		fmt.Println()
 		color.Cyan("Task Details:")
-		fmt.Printf("  Title: %s\n", title)
-		fmt.Printf("  Description: %s\n", description)
-		fmt.Printf("  Duration: %d minutes\n", duration)
-		fmt.Printf("  Category: %s\n", category)
-		fmt.Printf("  Priority: %s\n", priority)
+		fmt.Printf("  Title: %s\n", taskReq.Title)
+		fmt.Printf("  Description: %s\n", taskReq.Description)
+		fmt.Printf("  Duration: %d minutes\n", taskReq.DurationMinutes)
+		fmt.Printf("  Category: %s\n", taskReq.Category)
+		fmt.Printf("  Priority: %s\n", taskReq.Priority)
 		fmt.Printf("  AI Breakdown: %t\n", autoBreakdown)
 	}
 	
@@ -316,11 +824,20 @@ func (c *FocusForgeCLI) listTasks() {
 	color.Cyan("📋 Your Tasks")
 	fmt.Println()
 	
-	color.Yellow("Fetching your tasks...")
-	
 	if c.apiClient != nil {
+		limit, ok := promptListLimit(c.config.listLimit())
+		if !ok {
+			return
+		}
+		if !c.promptStatusFilter() {
+			return
+		}
+		
+		color.Yellow("Fetching your tasks...")
+		
 		// Make API call to get tasks
-		resp, err := c.apiClient.GetTasks("", "", 50)
+		status := strings.Join(c.statusFilter, ",")
+		resp, err := c.apiClient.GetTasks(status, "", limit)
 		if err != nil {
 			color.Red("❌ Failed to fetch tasks: %v", err)
 			fmt.Println()
@@ -329,21 +846,81 @@ func (c *FocusForgeCLI) listTasks() {
 			return
 		}
 		
+		// Gather any further pages first so the list is sorted as a whole
+		for resp.Success && resp.NextCursor != "" && offerLoadMore(len(resp.Tasks)) {
+			next, err := c.apiClient.GetTasksPage(status, "", limit, resp.NextCursor)
+			if err != nil {
+				color.Red("❌ Failed to fetch tasks: %v", err)
+				break
+			}
+			if !next.Success {
+				color.Red("❌ Failed to fetch tasks: %s", errorMessage(next))
+				break
+			}
+			resp.Tasks = append(resp.Tasks, next.Tasks...)
+			resp.NextCursor = next.NextCursor
+		}
+		
 		if resp.Success && resp.Tasks != nil {
-			if len(resp.Tasks) == 0 {
+			if len(c.statusFilter) > 0 {
+				color.Cyan("Showing: %s", statusFilterLabel(c.statusFilter))
+				fmt.Println()
+			}
+			
+			// Snoozed tasks stay out of the list until they wake up
+			index := indexTasks(resp.Tasks)
+			resp.Tasks = filterByStatus(resp.Tasks, c.statusFilter)
+			var snoozed []*Task
+			var visible []*Task
+			for _, task := range resp.Tasks {
+				if _, ok := snoozedUntil(task); ok {
+					snoozed = append(snoozed, task)
+				} else {
+					visible = append(visible, task)
+				}
+			}
+			resp.Tasks = visible
+			
+			if len(resp.Tasks) == 0 && len(snoozed) == 0 && len(c.statusFilter) > 0 {
+				color.Yellow("No tasks are %s.", statusFilterLabel(c.statusFilter))
+			} else if len(resp.Tasks) == 0 && len(snoozed) == 0 {
 				color.Yellow("No tasks found. Create your first task!")
 			} else {
+				// Most pressing first, using the escalated display priority
+				sort.SliceStable(resp.Tasks, func(i, j int) bool {
+					return priorityRank[effectivePriority(resp.Tasks[i])] > priorityRank[effectivePriority(resp.Tasks[j])]
+				})
+				
+				// Leave room for the number, duration, priority and status
+				titleWidth := terminalWidth() - 40
+				if titleWidth < 12 {
+					titleWidth = 12
+				}
+				
 				for i, task := range resp.Tasks {
-					statusColor := color.Green
-					if task.Status == "pending" {
-						statusColor = color.Yellow
-					} else if task.Status == "in_progress" {
-						statusColor = color.Cyan
+					if task.Status != "completed" {
+						if blockers := blockingTasks(task, index); len(blockers) > 0 {
+							dimmed.Printf("%d. 🔒 %s (%d min) - blocked by: %s\n", i+1, fitWidth(task.Title, titleWidth), task.DurationMinutes, fitWidth(taskTitles(blockers), titleWidth))
+							continue
+						}
+					}
+					
+					priority := effectivePriority(task)
+					priorityLabel := formatPriority(priority)
+					if priority != task.Priority {
+						priorityLabel = "⏰ " + priorityLabel
 					}
 					
-					fmt.Printf("%d. %s (%d min) - ", i+1, task.Title, task.DurationMinutes)
-					statusColor(task.Status)
+					fmt.Printf("%d. %s (%d min) [%s] - %s\n", i+1, labeledTitle(task, titleWidth), task.DurationMinutes, priorityLabel, formatStatus(task.Status))
+				}
+				
+				if len(snoozed) > 0 {
 					fmt.Println()
+					dimmed.Printf("💤 Snoozed (%d):\n", len(snoozed))
+					for _, task := range snoozed {
+						until, _ := snoozedUntil(task)
+						dimmed.Printf("  zzz %s - wakes %s\n", fitWidth(task.Title, titleWidth), c.formatTime(until, displayTimeLayout))
+					}
 				}
 				
 				if resp.Stats != nil {
@@ -354,10 +931,16 @@ func (c *FocusForgeCLI) listTasks() {
 					fmt.Printf("  • In Progress: %d\n", resp.Stats.InProgressTasks)
 					fmt.Printf("  • Pending: %d\n", resp.Stats.PendingTasks)
 					fmt.Printf("  • Completion Rate: %.1f%%\n", resp.Stats.CompletionRate)
+					if effort := effortSummary(resp.Stats); effort != "" {
+						fmt.Printf("  • Effort Completed: %s\n", effort)
+					}
+					if resp.Stats.AvgRating > 0 {
+						fmt.Printf("  • Average Rating: %.1f ★\n", resp.Stats.AvgRating)
+					}
 				}
 			}
 		} else {
-			color.Red("❌ Failed to fetch tasks: %s", resp.Error)
+			color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
 		}
 	} else {
 		color.Yellow("⚠️  API client not available - showing mock data")
@@ -373,16 +956,7 @@ func (c *FocusForgeCLI) listTasks() {
 			color.Yellow("No tasks found. Create your first task!")
 		} else {
 			for i, task := range tasks {
-				statusColor := color.Green
-				if task["status"] == "pending" {
-					statusColor = color.Yellow
-				} else if task["status"] == "in_progress" {
-					statusColor = color.Cyan
-				}
-				
-				fmt.Printf("%d. %s (%s min) - ", i+1, task["title"], task["duration"])
-				statusColor(task["status"])
-				fmt.Println()
+				fmt.Printf("%d. %s (%s min) - %s\n", i+1, task["title"], task["duration"], formatStatus(task["status"]))
 			}
 		}
 	}
@@ -392,61 +966,299 @@ func (c *FocusForgeCLI) listTasks() {
 	bufio.NewReader(os.Stdin).ReadString('\n')
 }
 
-func (c *FocusForgeCLI) viewTaskDetails() {
-	color.Cyan("🔍 View Task Details")
-	fmt.Println()
-	
-	// TODO: Implement task details view
-	color.Yellow("Feature coming soon!")
-	fmt.Println()
+// selectTask shows an interactive picker over tasks and returns the chosen
+// one, or nil if the selection was cancelled
+func selectTask(label string, tasks []*Task) *Task {
+	taskPrompt := promptui.Select{
+		Label: label,
+		Items: tasks,
+		Templates: &promptui.SelectTemplates{
+			Active:   "▸ {{ .Title | cyan }} ({{ .DurationMinutes }} min)",
+			Inactive: "  {{ .Title }} ({{ .DurationMinutes }} min)",
+			Selected: "✓ {{ .Title }}",
+		},
+		Size: 10,
+	}
+	idx, _, err := taskPrompt.Run()
+	if err != nil {
+		color.Red("Error selecting task: %v", err)
+		return nil
+	}
+	return tasks[idx]
 }
 
-func (c *FocusForgeCLI) editTask() {
-	color.Cyan("✏️  Edit Task")
-	fmt.Println()
-	
-	// TODO: Implement task editing
-	color.Yellow("Feature coming soon!")
-	fmt.Println()
+// priorityRank orders task priorities from least to most pressing
+var priorityRank = map[string]int{"low": 1, "medium": 2, "high": 3, "urgent": 4}
+
+// effectivePriority returns the priority used to color and sort a task in
+// lists. Tasks due within the next 24 hours (or already overdue) are bumped to
+// at least "high" so they are not buried. This only affects display; the
+// task's stored priority on the backend is never changed.
+func effectivePriority(task *Task) string {
+	due, ok := parseTimestamp(task.DueDate)
+	if !ok || task.Status == "completed" {
+		return task.Priority
+	}
+	if time.Until(due) <= 24*time.Hour && priorityRank[task.Priority] < priorityRank["high"] {
+		return "high"
+	}
+	return task.Priority
 }
 
-func (c *FocusForgeCLI) deleteTask() {
-	color.Cyan("🗑️  Delete Task")
-	fmt.Println()
-	
-	// TODO: Implement task deletion
-	color.Yellow("Feature coming soon!")
-	fmt.Println()
+// priorityColor returns the color used to render a priority label. The
+// color-blind safe theme uses magenta in place of red.
+func priorityColor(priority string) *color.Color {
+	if colorBlindSafe {
+		switch priority {
+		case "urgent":
+			return color.New(color.FgMagenta, color.Bold)
+		case "high":
+			return color.New(color.FgMagenta)
+		case "medium":
+			return color.New(color.FgYellow)
+		default:
+			return color.New(color.FgWhite)
+		}
+	}
+	switch priority {
+	case "urgent":
+		return color.New(color.FgRed, color.Bold)
+	case "high":
+		return color.New(color.FgRed)
+	case "medium":
+		return color.New(color.FgYellow)
+	default:
+		return color.New(color.FgWhite)
+	}
 }
 
-func (c *FocusForgeCLI) showTaskDashboard() {
-	color.Cyan("📊 Task Dashboard")
+func (c *FocusForgeCLI) viewTaskDetails() {
+	color.Cyan("🔍 View Task Details")
 	fmt.Println()
-	
-	color.Yellow("Loading your dashboard...")
-	
-	if c.apiClient != nil {
-		// Make API call to get dashboard
-		resp, err := c.apiClient.GetDashboard()
-		if err != nil {
-			color.Red("❌ Failed to load dashboard: %v", err)
-			fmt.Println()
-			fmt.Println("Press Enter to continue...")
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load task details")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+	if len(resp.Tasks) == 0 {
+		color.Yellow("No tasks found. Create your first task!")
+		fmt.Println()
+		return
+	}
+
+	selected := selectTask("Which task?", resp.Tasks)
+	if selected == nil {
+		return
+	}
+
+	for {
+		taskResp, err := c.apiClient.GetTask(selected.ID)
+		if err != nil {
+			color.Red("❌ Failed to load task: %v", err)
+			fmt.Println()
+			return
+		}
+		if !taskResp.Success || taskResp.Task == nil {
+			color.Red("❌ Failed to load task: %s", errorMessage(taskResp))
+			fmt.Println()
+			return
+		}
+		task := taskResp.Task
+
+		fmt.Println()
+		color.Cyan("Task Details:")
+		fmt.Printf("  ID: %s\n", task.ID)
+		fmt.Printf("  Title: %s\n", labeledTitle(task, 0))
+		fmt.Printf("  Description: %s\n", task.Description)
+		fmt.Printf("  Duration: %d minutes\n", task.DurationMinutes)
+		fmt.Printf("  Category: %s\n", task.Category)
+		fmt.Printf("  Priority: %s\n", formatPriority(task.Priority))
+		if task.EffortPoints > 0 {
+			fmt.Printf("  Effort: %d pts\n", task.EffortPoints)
+		}
+		fmt.Printf("  Status: %s\n", formatStatus(task.Status))
+		if task.Rating > 0 {
+			fmt.Printf("  Rating: %s\n", stars(task.Rating))
+		}
+		if task.DueDate != "" {
+			fmt.Printf("  Due: %s\n", c.formatTimestamp(task.DueDate))
+		}
+		if task.CreatedAt != "" {
+			fmt.Printf("  Created: %s\n", c.formatTimestamp(task.CreatedAt))
+		}
+		if task.UpdatedAt != "" {
+			fmt.Printf("  Updated: %s\n", c.formatTimestamp(task.UpdatedAt))
+		}
+		fmt.Println()
+
+		c.printTaskNotes(task.ID)
+
+		done := 0
+		for _, block := range task.Blocks {
+			if block.IsCompleted() {
+				done++
+			}
+		}
+
+		label := "Select a block to check it off"
+		if len(task.Blocks) == 0 {
+			color.Yellow("This task has no blocks.")
+			fmt.Println()
+			label = "What would you like to do?"
+		} else {
+			color.Cyan("🧱 Blocks (%d/%d done):", done, len(task.Blocks))
+			printBlockTotals(task)
+		}
+
+		items := make([]string, 0, len(task.Blocks)+2)
+		for i, block := range task.Blocks {
+			check := "[ ]"
+			if block.IsCompleted() {
+				check = "[x]"
+			}
+			items = append(items, fmt.Sprintf("%s %d. %s (%d min)", check, i+1, block.Title, block.DurationMinutes))
+		}
+		if len(task.Blocks) > 0 {
+			items = append(items, "⏱️  Edit Block Duration")
+		}
+		if len(task.Blocks) > 1 {
+			items = append(items, "🔀 Reorder Blocks")
+		}
+		if task.Status != "completed" {
+			items = append(items, "✅ Mark Complete")
+		}
+		items = append(items, "📝 Add Note", copyLabel, "🔙 Back")
+
+		blockPrompt := promptui.Select{
+			Label: label,
+			Items: items,
+			Size:  10,
+		}
+		idx, choice, err := blockPrompt.Run()
+		if err != nil {
+			return
+		}
+		if idx >= len(task.Blocks) {
+			switch choice {
+			case "⏱️  Edit Block Duration":
+				c.editBlockDuration(task)
+				continue
+			case "🔀 Reorder Blocks":
+				c.reorderBlocks(task)
+				continue
+			case "✅ Mark Complete":
+				c.completeTask(task)
+				continue
+			case "📝 Add Note":
+				c.addTaskNote(task.ID)
+				continue
+			case copyLabel:
+				c.copyTaskDetails(task)
+				continue
+			}
+			return
+		}
+
+		block := task.Blocks[idx]
+		if block.IsCompleted() {
+			color.Yellow("Block \"%s\" is already completed - checking a block off can't be undone.", block.Title)
+			continue
+		}
+
+		blockResp, err := c.apiClient.CompleteBlock(task.ID, block.ID)
+		if err != nil {
+			color.Red("❌ Failed to complete block: %v", err)
+			continue
+		}
+		if !blockResp.Success {
+			color.Red("❌ Failed to complete block: %s", errorMessage(blockResp))
+			continue
+		}
+		color.Green("✓ Block \"%s\" completed!", block.Title)
+
+		if done+1 == len(task.Blocks) && task.Status != "completed" {
+			color.Green("🎉 All blocks done!")
+			c.completeTask(task)
+		}
+	}
+}
+
+func (c *FocusForgeCLI) editTask() {
+	color.Cyan("✏️  Edit Task")
+	fmt.Println()
+	
+	// TODO: Implement task editing
+	color.Yellow("Feature coming soon!")
+	fmt.Println()
+}
+
+func (c *FocusForgeCLI) deleteTask() {
+	color.Cyan("🗑️  Delete Task")
+	fmt.Println()
+	
+	// TODO: Implement task deletion
+	color.Yellow("Feature coming soon!")
+	fmt.Println()
+}
+
+func (c *FocusForgeCLI) showTaskDashboard() {
+	color.Cyan("📊 Task Dashboard")
+	fmt.Println()
+	
+	color.Yellow("Loading your dashboard...")
+	
+	if c.apiClient != nil {
+		// Make API call to get dashboard
+		resp, err := c.apiClient.GetDashboard()
+		if err != nil {
+			color.Red("❌ Failed to load dashboard: %v", err)
+			fmt.Println()
+			fmt.Println("Press Enter to continue...")
 			bufio.NewReader(os.Stdin).ReadString('\n')
 			return
 		}
 		
 		if resp.Success {
 			if resp.Stats != nil {
-				fmt.Println("📈 Task Statistics:")
-				fmt.Printf("  • Total Tasks: %d\n", resp.Stats.TotalTasks)
-				fmt.Printf("  • Completed: %d\n", resp.Stats.CompletedTasks)
-				fmt.Printf("  • In Progress: %d\n", resp.Stats.InProgressTasks)
-				fmt.Printf("  • Pending: %d\n", resp.Stats.PendingTasks)
-				fmt.Printf("  • Completion Rate: %.1f%%\n", resp.Stats.CompletionRate)
-				fmt.Printf("  • Total Minutes Planned: %d\n", resp.Stats.TotalMinutes)
-				fmt.Printf("  • Total Tokens Earned: %d\n", resp.Stats.TotalTokens)
-				fmt.Printf("  • Average Difficulty: %.1f\n", resp.Stats.AvgDifficulty)
+				stats := []string{
+					"📈 Task Statistics",
+					"",
+					fmt.Sprintf("• Total Tasks: %d", resp.Stats.TotalTasks),
+					fmt.Sprintf("• Completed: %d", resp.Stats.CompletedTasks),
+					fmt.Sprintf("• In Progress: %d", resp.Stats.InProgressTasks),
+					fmt.Sprintf("• Pending: %d", resp.Stats.PendingTasks),
+					fmt.Sprintf("• Completion Rate: %.1f%%", resp.Stats.CompletionRate),
+					fmt.Sprintf("• Total Minutes Planned: %d", resp.Stats.TotalMinutes),
+					fmt.Sprintf("• Total Tokens Earned: %d", resp.Stats.TotalTokens),
+					fmt.Sprintf("• Average Difficulty: %.1f", resp.Stats.AvgDifficulty),
+				}
+				if effort := effortSummary(resp.Stats); effort != "" {
+					stats = append(stats, "• Effort Completed: "+effort)
+				}
+				if resp.Stats.AvgRating > 0 {
+					stats = append(stats, fmt.Sprintf("• Average Rating: %.1f ★", resp.Stats.AvgRating))
+				}
+				printBox(color.New(color.Reset), stats, roundedBox, 40, false)
+			}
+			
+			if m := c.todaysCommitment(); m != nil {
+				fmt.Println()
+				printBox(color.New(color.Reset), []string{
+					fmt.Sprintf("🤝 Today's Commitment: %s", commitmentProgress(m)),
+				}, roundedBox, 40, false)
 			}
 			
 			// Show next block if available
@@ -465,24 +1277,30 @@ func (c *FocusForgeCLI) showTaskDashboard() {
 				fmt.Println("  • You have active tasks in progress")
 			}
 		} else {
-			color.Red("❌ Failed to load dashboard: %s", resp.Error)
+			color.Red("❌ Failed to load dashboard: %s", errorMessage(resp))
 		}
 	} else {
 		color.Yellow("⚠️  API client not available - showing mock data")
 		
 		// Mock dashboard data
-		fmt.Println("📈 Task Statistics:")
-		fmt.Println("  • Total Tasks: 15")
-		fmt.Println("  • Completed: 8")
-		fmt.Println("  • In Progress: 3")
-		fmt.Println("  • Pending: 4")
-		fmt.Println("  • Completion Rate: 53%")
+		printBox(color.New(color.Reset), []string{
+			"📈 Task Statistics",
+			"",
+			"• Total Tasks: 15",
+			"• Completed: 8",
+			"• In Progress: 3",
+			"• Pending: 4",
+			"• Completion Rate: 53%",
+		}, roundedBox, 40, false)
 		fmt.Println()
 		
-		fmt.Println("🎯 Current Focus:")
-		fmt.Println("  • Active Task: Complete project proposal")
-		fmt.Println("  • Time Remaining: 45 minutes")
-		fmt.Println("  • Next Break: 15 minutes")
+		printBox(color.New(color.Reset), []string{
+			"🎯 Current Focus",
+			"",
+			"• Active Task: Complete project proposal",
+			"• Time Remaining: 45 minutes",
+			"• Next Break: 15 minutes",
+		}, roundedBox, 40, false)
 	}
 	
 	fmt.Println()
@@ -490,37 +1308,53 @@ func (c *FocusForgeCLI) showTaskDashboard() {
 	bufio.NewReader(os.Stdin).ReadString('\n')
 }
 
-func (c *FocusForgeCLI) showFocusSessions() {
+func (c *FocusForgeCLI) showFocusSessions(parent string) {
+	path := crumb(parent, "Focus")
 	for {
+		renderHeader(path)
+		
 		menuItems := []string{
 			"▶️  Start Focus Session",
+			"▶️  Resume Task",
 			"⏸️  Current Session",
 			"⏹️  End Session",
 			"📊 Session History",
+			"🤝 Daily Commitment",
 			"🔙 Back to Main Menu",
 		}
 		
 		prompt := promptui.Select{
-			Label: "Focus Sessions - What would you like to do?",
+			Label: "What would you like to do?",
 			Items: menuItems,
 			Size:  10,
 		}
 		
 		_, result, err := prompt.Run()
+		if wentBack(err) {
+			return
+		}
 		if err != nil {
 			color.Red("Error selecting menu item: %v", err)
 			return
 		}
 		
+		if !isBackItem(result) {
+			renderHeader(crumb(path, menuLabel(result)))
+		}
+		
 		switch result {
 		case "▶️  Start Focus Session":
 			c.startFocusSession()
+		case "▶️  Resume Task":
+			c.resumeTask()
 		case "⏸️  Current Session":
 			c.showCurrentSession()
 		case "⏹️  End Session":
 			c.endSession()
 		case "📊 Session History":
 			c.showSessionHistory()
+		case "🤝 Daily Commitment":
+			c.showCommitment()
 		case "🔙 Back to Main Menu":
 			return
 		}
@@ -530,85 +1364,579 @@ func (c *FocusForgeCLI) showFocusSessions() {
 func (c *FocusForgeCLI) startFocusSession() {
 	color.Cyan("🎯 Starting Focus Session")
 	fmt.Println()
-	
-	// TODO: Implement focus session start
-	color.Yellow("Feature coming soon!")
+
+	if active := c.session(); active != nil {
+		color.Yellow("⚠️  You already have an active session on: %s", active.TaskTitle)
+		fmt.Println()
+		return
+	}
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot start a session")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+
+	// Tasks waiting on unfinished prerequisites can't be started yet
+	index := indexTasks(resp.Tasks)
+	var tasks []*Task
+	var blocked int
+	for _, task := range resp.Tasks {
+		if task.Status == "completed" {
+			continue
+		}
+		if blockers := blockingTasks(task, index); len(blockers) > 0 {
+			if blocked == 0 {
+				dimmed.Println("Blocked tasks:")
+			}
+			dimmed.Printf("  🔒 %s - waiting on: %s\n", task.Title, taskTitles(blockers))
+			blocked++
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	if blocked > 0 {
+		fmt.Println()
+	}
+	if len(tasks) == 0 {
+		if blocked > 0 {
+			color.Yellow("All open tasks are blocked by unfinished prerequisites.")
+		} else {
+			color.Yellow("No open tasks found - you can still start a freeform session.")
+		}
+		fmt.Println()
+	}
+
+	// A freeform session can be attached to a task later
+	tasks = append([]*Task{c.freeformTask()}, tasks...)
+	task := selectTask("Which task will you focus on?", tasks)
+	if task == nil {
+		return
+	}
+
+	c.startSessionOnTask(task)
+}
+
+// startSessionOnTask picks the session length as the session length setting
+// says, and starts the session on the backend
+func (c *FocusForgeCLI) startSessionOnTask(task *Task) {
+	if active := c.session(); active != nil {
+		color.Yellow("⚠️  You already have an active session on: %s", active.TaskTitle)
+		fmt.Println()
+		return
+	}
+
+	if !c.prerequisitesDone(task) {
+		color.Yellow("Session not started")
+		fmt.Println()
+		return
+	}
+
+	duration, ok := c.sessionMinutes(task)
+	if !ok {
+		return
+	}
+
+	goalPrompt := promptui.Prompt{
+		Label: "Goal for this session, e.g. \"draft the intro\" (optional)",
+	}
+	goal, err := goalPrompt.Run()
+	if err != nil {
+		return
+	}
+	goal = strings.TrimSpace(goal)
+
+	energy, ok := selectEnergy()
+	if !ok {
+		return
+	}
+
+	if !c.checkTimeBudget(task, duration) {
+		color.Yellow("Session not started")
+		fmt.Println()
+		return
+	}
+
+	if !c.runChecklist() {
+		color.Yellow("Session not started")
+		fmt.Println()
+		return
+	}
+	if !c.runStartBuffer() {
+		color.Yellow("Session not started")
+		fmt.Println()
+		return
+	}
+
+	color.Yellow("Starting session...")
+
+	// Link the session to the block being worked through, so it can be
+	// checked off and auto-chaining moves on to the one after
+	blockID := ""
+	if block := nextBlock(task); block != nil {
+		blockID = block.ID
+	}
+
+	session, err := c.launchSession(task, duration, blockID, goal, energy)
+	if err != nil {
+		color.Red("❌ Failed to start session: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	c.rememberAction(fmt.Sprintf("Start a session on \"%s\"", task.Title), false, func() { c.startSessionOnTask(task) })
+
+	color.Green("✓ Focus session started on: %s", task.Title)
+	fmt.Printf("  Duration: %d minutes\n", duration)
+	fmt.Printf("  Ends at: %s\n", c.formatTime(session.StartedAt.Add(time.Duration(duration)*time.Minute), "15:04"))
+	if goal != "" {
+		fmt.Printf("  Goal: %s\n", goal)
+	}
 	fmt.Println()
+	waitForEnter()
+}
+
+// launchSession starts a session on the backend and sets up the local
+// session, its timer and focus aids. blockID names the task block being
+// worked through, goal what the user means to get done and energy how
+// they feel going in, if known.
+func (c *FocusForgeCLI) launchSession(task *Task, duration int, blockID, goal, energy string) (*focusSession, error) {
+	resp, err := c.apiClient.StartSession(SessionStartRequest{
+		TaskID:          task.ID,
+		DurationMinutes: duration,
+		Goal:            goal,
+		Energy:          energy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success || resp.Session == nil {
+		return nil, fmt.Errorf("%s", errorMessage(resp))
+	}
+
+	appLog.Info("session started", "session", resp.Session.ID, "task", task.ID, "minutes", duration)
+	session := newFocusSession(resp.Session.ID, task, duration)
+	session.BlockID = blockID
+	session.Goal = goal
+	c.startAmbient(session)
+	c.setSession(session)
+	c.persistSession(session)
+	c.goBackground(func() { c.runTimer(session) })
+
+	c.setDoNotDisturb(true)
+	c.markInProgress(task)
+	return session, nil
+}
+
+// markInProgress moves a pending task to in_progress once a session starts
+// on it, updating task in place. A failure only warns, since the session
+// itself is already running.
+func (c *FocusForgeCLI) markInProgress(task *Task) {
+	if task.ID == "" || task.Status != "pending" {
+		return
+	}
+
+	resp, err := c.apiClient.UpdateTask(task.ID, TaskUpdateRequest{Status: "in_progress"})
+	if err != nil {
+		color.Yellow("⚠️  Couldn't mark the task in progress: %v", err)
+		return
+	}
+	if !resp.Success {
+		color.Yellow("⚠️  Couldn't mark the task in progress: %s", errorMessage(resp))
+		return
+	}
+
+	appLog.Info("task marked in progress", "task", task.ID)
+	task.Status = "in_progress"
 }
 
 func (c *FocusForgeCLI) showCurrentSession() {
-	color.Cyan("⏸️  Current Session")
-	fmt.Println()
-	
-	// TODO: Show current session status
-	color.Yellow("No active session")
-	fmt.Println()
+	for {
+		color.Cyan("⏸️  Current Session")
+		fmt.Println()
+
+		session := c.session()
+		if session == nil {
+			color.Yellow("No active session")
+			fmt.Println()
+			return
+		}
+		session.touch()
+		c.welcomeBack(session)
+
+		elapsed := session.elapsed()
+		remaining := session.planned() - elapsed
+
+		fmt.Printf("  Task: %s\n", session.TaskTitle)
+		if session.Goal != "" {
+			color.New(color.FgCyan, color.Bold).Printf("  🎯 Goal: %s\n", session.Goal)
+		}
+		fmt.Printf("  Started: %s\n", c.formatTime(session.StartedAt, "15:04"))
+		fmt.Printf("  Focused: %s\n", formatDuration(elapsed, c.config.DurationRounding))
+		if session.isPaused() {
+			color.Yellow("  ⏸️  Paused")
+		} else if remaining > 0 {
+			fmt.Printf("  Remaining: %d minutes\n", int(remaining.Minutes())+1)
+		} else {
+			color.Green("  Planned time is up - end the session to log it!")
+		}
+		if pauses := session.pauseCount(); pauses > 0 {
+			fmt.Printf("  Pauses: %d\n", pauses)
+		}
+		if distractions := session.distractionCount(); distractions > 0 {
+			fmt.Printf("  Distractions: %d\n", distractions)
+		}
+		if err := session.lastHeartbeatErr(); err != nil {
+			color.Yellow("  ⚠️  Live sync to the backend is failing: %v", err)
+		}
+		fmt.Println()
+
+		toggle := "⏸️  Pause"
+		if session.isPaused() {
+			toggle = "▶️  Resume"
+		}
+		controls := []string{toggle, distractionLabel, quickCaptureLabel, "⏹️  End Session", "🛑 Abort Session"}
+		if !c.config.StrictFocus {
+			controls = append(controls, "🔙 Back")
+		}
+		if session.isFreeform() {
+			controls = append([]string{"🏷️  Assign Task"}, controls...)
+		}
+
+		prompt := promptui.Select{
+			Label: "Session controls",
+			Items: controls,
+		}
+		_, result, err := prompt.Run()
+		if err != nil {
+			return
+		}
+
+		switch result {
+		case "⏸️  Pause":
+			session.pause()
+			c.persistSession(session)
+			color.Yellow("⏸️  Session paused")
+		case "▶️  Resume":
+			session.resume()
+			c.persistSession(session)
+			color.Green("▶️  Session resumed")
+		case distractionLabel:
+			c.noteDistraction(session)
+		case quickCaptureLabel:
+			c.quickCapture()
+		case "🏷️  Assign Task":
+			c.assignSessionTask(session)
+		case "⏹️  End Session":
+			c.finishSession(false)
+			return
+		case "🛑 Abort Session":
+			c.finishSession(true)
+			return
+		case "🔙 Back":
+			return
+		}
+		fmt.Println()
+	}
 }
 
 func (c *FocusForgeCLI) endSession() {
 	color.Cyan("⏹️  End Session")
 	fmt.Println()
-	
-	// TODO: Implement session end
-	color.Yellow("Feature coming soon!")
+
+	if c.session() == nil {
+		color.Yellow("No active session")
+		fmt.Println()
+		return
+	}
+
+	c.finishSession(false)
+}
+
+// finishSession ends the active session on the backend, recording its focus
+// score, and shows the result. Aborted sessions are recorded as such. It
+// reports whether the session was ended.
+func (c *FocusForgeCLI) finishSession(aborted bool) bool {
+	session := c.session()
+	if session == nil {
+		return false
+	}
+
+	if !aborted && session.isFreeform() {
+		c.offerSessionAssignment(session)
+	}
+
+	if !aborted && session.Goal != "" && session.GoalAchieved == nil {
+		goalPrompt := promptui.Select{
+			Label: fmt.Sprintf("Did you achieve your goal: \"%s\"?", session.Goal),
+			Items: []string{"✅ Yes", "❌ Not this time"},
+		}
+		if idx, _, err := goalPrompt.Run(); err == nil {
+			achieved := idx == 0
+			session.GoalAchieved = &achieved
+		}
+	}
+
+	if aborted {
+		color.Yellow("Aborting session...")
+	} else {
+		color.Yellow("Ending session...")
+	}
+
+	outcome, err := c.closeSession(session, aborted)
+	if err != nil {
+		color.Red("❌ Failed to end session: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return false
+	}
+
+	c.printSummaryCard(session, outcome)
+	if session.GoalAchieved != nil && *session.GoalAchieved {
+		c.celebrate(fmt.Sprintf("Goal reached: %s", session.Goal))
+	}
+	c.celebrateAchievements(outcome.NewAchievements)
+	if c.config.MoodAfterSession && !c.onVacation(time.Now()) {
+		fmt.Println()
+		c.promptSessionMood()
+	}
+	if !aborted {
+		c.reportCommitmentProgress()
+		c.chainNextBlock(session)
+	}
 	fmt.Println()
+	waitForEnter()
+	return true
+}
+
+// closeSession reports a session's outcome to the backend and, once the
+// backend has accepted it, tears down the local session. It never prompts, so
+// it is also used when shutting down.
+func (c *FocusForgeCLI) closeSession(session *focusSession, aborted bool) (Session, error) {
+	outcome := Session{
+		TaskID:          session.TaskID,
+		DurationMinutes: session.DurationMinutes,
+		ActualMinutes:   int(session.elapsed().Minutes()),
+		Pauses:          session.pauseCount(),
+		Aborted:         aborted,
+		Distractions:    session.distractionCount(),
+	}
+	outcome.FocusScore = focusScore(outcome)
+
+	resp, err := c.apiClient.EndSession(session.ID, SessionEndRequest{
+		ActualMinutes: outcome.ActualMinutes,
+		Pauses:        outcome.Pauses,
+		Aborted:       outcome.Aborted,
+		FocusScore:    outcome.FocusScore,
+		Distractions:  outcome.Distractions,
+		Goal:          session.Goal,
+		GoalAchieved:  session.GoalAchieved,
+	})
+	if err != nil {
+		return outcome, err
+	}
+	if !resp.Success {
+		return outcome, fmt.Errorf("%s", errorMessage(resp))
+	}
+	if resp.Session != nil {
+		outcome.TokensEarned = resp.Session.TokensEarned
+		outcome.NewAchievements = resp.Session.NewAchievements
+	}
+	appLog.Info("session ended", "session", session.ID, "aborted", aborted, "minutes", outcome.ActualMinutes, "score", outcome.FocusScore)
+
+	session.stop()
+	c.setSession(nil)
+	c.setDoNotDisturb(false)
+	if err := clearSessionState(); err != nil {
+		color.Yellow("⚠️  %v", err)
+	}
+	return outcome, nil
 }
 
 func (c *FocusForgeCLI) showSessionHistory() {
 	color.Cyan("📊 Session History")
 	fmt.Println()
-	
-	// TODO: Show session history
-	color.Yellow("Feature coming soon!")
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load session history")
+		fmt.Println()
+		return
+	}
+
+	limit, ok := promptListLimit(c.config.listLimit())
+	if !ok {
+		return
+	}
+
+	resp, err := c.apiClient.GetSessionHistory(limit)
+	if err != nil {
+		color.Red("❌ Failed to fetch session history: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch session history: %s", errorMessage(resp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if len(resp.Sessions) == 0 {
+		color.Yellow("No sessions yet. Start your first focus session!")
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	sessions := resp.Sessions
+	c.printSessionHistory(sessions, 0)
+	for cursor := resp.NextCursor; cursor != "" && offerLoadMore(len(sessions)); {
+		next, err := c.apiClient.GetSessionHistoryPage(limit, cursor)
+		if err != nil {
+			color.Red("❌ Failed to fetch session history: %v", err)
+			break
+		}
+		if !next.Success {
+			color.Red("❌ Failed to fetch session history: %s", errorMessage(next))
+			break
+		}
+		c.printSessionHistory(next.Sessions, len(sessions))
+		sessions = append(sessions, next.Sessions...)
+		cursor = next.NextCursor
+	}
+
+	// Sessions are newest first, so compare the recent half with the older half
+	if len(sessions) >= 4 {
+		half := len(sessions) / 2
+		recent := averageScore(sessions[:half])
+		older := averageScore(sessions[half:])
+
+		fmt.Println()
+		switch {
+		case recent > older:
+			color.Green("📈 Your focus score is trending up (%.0f → %.0f)", older, recent)
+		case recent < older:
+			color.Yellow("📉 Your focus score is trending down (%.0f → %.0f)", older, recent)
+		default:
+			color.Cyan("➡️  Your focus score is steady at %.0f", recent)
+		}
+		printDistractionTrend(sessions[:half], sessions[half:])
+	}
+
 	fmt.Println()
+	waitForEnter()
+}
+
+// printSessionHistory lists sessions one per line, numbered after the first
+// skip already shown
+func (c *FocusForgeCLI) printSessionHistory(sessions []*Session, skip int) {
+	for i, session := range sessions {
+		started := session.StartedAt
+		if t, ok := parseTimestamp(session.StartedAt); ok {
+			started = c.formatTime(t, "Jan 2 15:04")
+		}
+		fmt.Printf("%d. %s - %s/%s - score %s", skip+i+1, started,
+			c.formatMinutes(session.ActualMinutes), c.formatMinutes(session.DurationMinutes),
+			scoreColor(session.FocusScore).Sprintf("%d", session.FocusScore))
+		if session.Distractions > 0 {
+			fmt.Printf(" - 📵 %d", session.Distractions)
+		}
+		if session.Aborted {
+			color.New(color.FgRed).Print(" (aborted)")
+		}
+		fmt.Println()
+	}
+}
+
+// averageScore returns the mean focus score of sessions
+func averageScore(sessions []*Session) float64 {
+	if len(sessions) == 0 {
+		return 0
+	}
+	total := 0
+	for _, session := range sessions {
+		total += session.FocusScore
+	}
+	return float64(total) / float64(len(sessions))
 }
 
-func (c *FocusForgeCLI) showMoodTracking() {
+func (c *FocusForgeCLI) showMoodTracking(parent string) {
+	path := crumb(parent, "Mood")
 	for {
+		renderHeader(path)
+		
 		menuItems := []string{
+			"⚡ Quick Mood",
 			"😊 Log Mood",
 			"📊 Mood Trends",
 			"🔍 Mood Analysis",
+			"📥 Import Moods",
 			"🔙 Back to Main Menu",
 		}
 		
 		prompt := promptui.Select{
-			Label: "Mood Tracking - What would you like to do?",
+			Label: "What would you like to do?",
 			Items: menuItems,
 			Size:  10,
 		}
 		
 		_, result, err := prompt.Run()
+		if wentBack(err) {
+			return
+		}
 		if err != nil {
 			color.Red("Error selecting menu item: %v", err)
 			return
 		}
 		
+		if !isBackItem(result) {
+			renderHeader(crumb(path, menuLabel(result)))
+		}
+		
 		switch result {
+		case "⚡ Quick Mood":
+			c.showQuickMood()
 		case "😊 Log Mood":
 			c.logMood()
 		case "📊 Mood Trends":
 			c.showMoodTrends()
 		case "🔍 Mood Analysis":
 			c.showMoodAnalysis()
+		case "📥 Import Moods":
+			c.importMoods()
 		case "🔙 Back to Main Menu":
 			return
 		}
 	}
 }
 
+// moodChoices are the feelings offered when logging a mood
+var moodChoices = []string{
+	"😊 Happy", "😌 Content", "😤 Stressed", "😴 Tired",
+	"😡 Angry", "😰 Anxious", "😔 Sad", "🤔 Confused",
+	"😤 Frustrated", "😃 Excited", "😌 Relaxed", "😤 Overwhelmed",
+}
+
 func (c *FocusForgeCLI) logMood() {
 	color.Cyan("😊 Log Your Mood")
 	fmt.Println()
 	
 	moodPrompt := promptui.Select{
 		Label: "How are you feeling right now?",
-		Items: []string{
-			"😊 Happy", "😌 Content", "😤 Stressed", "😴 Tired",
-			"😡 Angry", "😰 Anxious", "😔 Sad", "🤔 Confused",
-			"😤 Frustrated", "😃 Excited", "😌 Relaxed", "😤 Overwhelmed",
-		},
-		Size: 12,
+		Items: moodChoices,
+		Size:  12,
 	}
 	
 	_, mood, err := moodPrompt.Run()
@@ -622,16 +1950,17 @@ func (c *FocusForgeCLI) logMood() {
 	
 	intensityPrompt := promptui.Select{
 		Label: "How intense is this feeling? (1-10)",
-		Items: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+		Items: c.intensityItems(),
+		Size:  10,
 	}
 	
-	_, intensityStr, err := intensityPrompt.Run()
+	intensityIdx, _, err := intensityPrompt.Run()
 	if err != nil {
 		color.Red("Error selecting intensity: %v", err)
 		return
 	}
 	
-	intensity, _ := strconv.Atoi(intensityStr)
+	intensity := intensityIdx + 1
 	
 	notePrompt := promptui.Prompt{
 		Label: "Any notes about your mood? (optional)",
@@ -643,15 +1972,36 @@ func (c *FocusForgeCLI) logMood() {
 	if c.apiClient != nil {
 		// Create mood request
 		moodReq := MoodLogRequest{
-			Feeling:   feeling,
-			Intensity: intensity,
-			Note:      note,
+			Feeling:        feeling,
+			Intensity:      intensity,
+			Note:           note,
+			IdempotencyKey: newIdempotencyKey(),
 		}
 		
 		// Make API call to log mood
-		resp, err := c.apiClient.LogMood(moodReq)
+		var resp *MoodResponse
+		err := withRetryPrompt(func() error {
+			var err error
+			resp, err = c.apiClient.LogMood(moodReq)
+			return err
+		})
+		// Let the user correct just the fields the backend rejected
+		for verr := asValidationError(err); verr != nil && c.fixMoodFields(&moodReq, verr); verr = asValidationError(err) {
+			color.Yellow("Logging your mood...")
+			moodReq.IdempotencyKey = newIdempotencyKey()
+			err = withRetryPrompt(func() error {
+				var err error
+				resp, err = c.apiClient.LogMood(moodReq)
+				return err
+			})
+		}
 		if err != nil {
 			color.Red("❌ Failed to log mood: %v", err)
+			if offline(err) {
+				// Keep when it was felt, not when it finally syncs
+				moodReq.Timestamp = time.Now().Format(time.RFC3339)
+				c.queueOffline(queuedMutation{Kind: mutationLogMood, Mood: &moodReq, IdempotencyKey: moodReq.IdempotencyKey})
+			}
 			fmt.Println()
 			fmt.Println("Press Enter to continue...")
 			bufio.NewReader(os.Stdin).ReadString('\n')
@@ -659,20 +2009,24 @@ func (c *FocusForgeCLI) logMood() {
 		}
 		
 		if resp.Success {
+			appLog.Info("mood logged", "feeling", moodReq.Feeling, "intensity", moodReq.Intensity)
+			c.rememberAction(fmt.Sprintf("Log mood %s (%d/10)", moodReq.Feeling, moodReq.Intensity), false, func() { c.replayMood(moodReq) })
 			color.Green("✓ Mood logged successfully!")
 			fmt.Println()
 			color.Cyan("Mood Details:")
 			if resp.MoodLog != nil {
 				fmt.Printf("  ID: %s\n", resp.MoodLog.ID)
 				fmt.Printf("  Feeling: %s\n", resp.MoodLog.Feeling)
-				fmt.Printf("  Intensity: %d/10\n", resp.MoodLog.Intensity)
+				fmt.Printf("  Intensity: %s\n", c.formatIntensity(resp.MoodLog.Intensity))
 				if resp.MoodLog.Note != "" {
 					fmt.Printf("  Notes: %s\n", resp.MoodLog.Note)
 				}
 				if resp.MoodLog.Timestamp != "" {
-					fmt.Printf("  Timestamp: %s\n", resp.MoodLog.Timestamp)
+					fmt.Printf("  Timestamp: %s\n", c.formatTimestamp(resp.MoodLog.Timestamp))
 				}
 			}
+			fmt.Println()
+			c.offerMoodUndo(resp.MoodLog)
 			
 			// Show patterns if available
 			if resp.Patterns != nil {
@@ -682,7 +2036,7 @@ func (c *FocusForgeCLI) logMood() {
 				fmt.Println("  • Your mood has been tracked")
 			}
 		} else {
-			color.Red("❌ Failed to log mood: %s", resp.Error)
+			color.Red("❌ Failed to log mood: %s", errorMessage(resp))
 		}
 	} else {
 		color.Yellow("⚠️  API client not available - using mock data")
@@ -690,7 +2044,7 @@ func (c *FocusForgeCLI) logMood() {
 		fmt.Println()
 		color.Cyan("Mood Details:")
 		fmt.Printf("  Feeling: %s\n", feeling)
-		fmt.Printf("  Intensity: %d/10\n", intensity)
+		fmt.Printf("  Intensity: %s\n", c.formatIntensity(intensity))
 		if note != "" {
 			fmt.Printf("  Notes: %s\n", note)
 		}
@@ -703,26 +2057,73 @@ func (c *FocusForgeCLI) logMood() {
 	bufio.NewReader(os.Stdin).ReadString('\n')
 }
 
-func (c *FocusForgeCLI) showMoodTrends() {
-	color.Cyan("📊 Mood Trends")
-	fmt.Println()
-	
-	// TODO: Show mood trends
-	color.Yellow("Feature coming soon!")
-	fmt.Println()
-}
-
-func (c *FocusForgeCLI) showMoodAnalysis() {
-	color.Cyan("🔍 Mood Analysis")
+func (c *FocusForgeCLI) showMoodTrends() {
+	color.Cyan("📊 Mood Trends")
 	fmt.Println()
 	
-	// TODO: Show mood analysis
-	color.Yellow("Feature coming soon!")
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load mood logs")
+		fmt.Println()
+		return
+	}
+	
+	limit, ok := promptListLimit(c.config.listLimit())
+	if !ok {
+		return
+	}
+	
+	resp, err := c.apiClient.GetMoodLogs(limit)
+	if err != nil {
+		color.Red("❌ Failed to fetch mood logs: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch mood logs: %s", errorMessage(resp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if len(resp.MoodLogs) == 0 {
+		color.Yellow("No moods logged yet. Log your first mood!")
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	
+	for _, entry := range resp.MoodLogs {
+		c.printMoodLog(entry)
+	}
+	loaded := len(resp.MoodLogs)
+	for cursor := resp.NextCursor; cursor != "" && offerLoadMore(loaded); {
+		next, err := c.apiClient.GetMoodLogsPage(limit, cursor)
+		if err != nil {
+			color.Red("❌ Failed to fetch mood logs: %v", err)
+			break
+		}
+		if !next.Success {
+			color.Red("❌ Failed to fetch mood logs: %s", errorMessage(next))
+			break
+		}
+		for _, entry := range next.MoodLogs {
+			c.printMoodLog(entry)
+		}
+		loaded += len(next.MoodLogs)
+		cursor = next.NextCursor
+	}
+	fmt.Println()
+	fmt.Printf("Intensity: %s low  %s medium  %s high\n",
+		intensitySwatch(1), intensitySwatch(5), intensitySwatch(8))
 	fmt.Println()
+	waitForEnter()
 }
 
-func (c *FocusForgeCLI) showGamification() {
+func (c *FocusForgeCLI) showGamification(parent string) {
+	path := crumb(parent, "Rewards")
 	for {
+		renderHeader(path)
+		
 		menuItems := []string{
 			"💰 View Points & Level",
 			"🏆 Achievements",
@@ -732,17 +2133,24 @@ func (c *FocusForgeCLI) showGamification() {
 		}
 		
 		prompt := promptui.Select{
-			Label: "Gamification - What would you like to do?",
+			Label: "What would you like to do?",
 			Items: menuItems,
 			Size:  10,
 		}
 		
 		_, result, err := prompt.Run()
+		if wentBack(err) {
+			return
+		}
 		if err != nil {
 			color.Red("Error selecting menu item: %v", err)
 			return
 		}
 		
+		if !isBackItem(result) {
+			renderHeader(crumb(path, menuLabel(result)))
+		}
+		
 		switch result {
 		case "💰 View Points & Level":
 			c.showPointsAndLevel()
@@ -794,13 +2202,63 @@ func (c *FocusForgeCLI) showProgressStats() {
 	fmt.Println()
 }
 
-func (c *FocusForgeCLI) showAnalytics() {
-	color.Cyan("📊 Analytics & Insights")
-	fmt.Println()
-	
-	// TODO: Show analytics
-	color.Yellow("Feature coming soon!")
-	fmt.Println()
+func (c *FocusForgeCLI) showAnalytics(parent string) {
+	path := crumb(parent, "Analytics")
+	for {
+		renderHeader(path)
+		
+		menuItems := []string{
+			"📄 Generate Report",
+			"🔗 Mood vs Productivity",
+			"📅 Activity Heatmap",
+			"🗂️  Time by Category",
+			"🕰️  Day Timeline",
+			"🔋 Energy vs Focus",
+			"🏅 All-Time Stats",
+			"🗓️  Weekly Review",
+			"🔙 Back to Main Menu",
+		}
+
+		prompt := promptui.Select{
+			Label: "What would you like to do?",
+			Items: menuItems,
+			Size:  10,
+		}
+
+		_, result, err := prompt.Run()
+		if wentBack(err) {
+			return
+		}
+		if err != nil {
+			color.Red("Error selecting menu item: %v", err)
+			return
+		}
+
+		if !isBackItem(result) {
+			renderHeader(crumb(path, menuLabel(result)))
+		}
+		
+		switch result {
+		case "📄 Generate Report":
+			c.showGenerateReport()
+		case "🔗 Mood vs Productivity":
+			c.showMoodProductivity()
+		case "📅 Activity Heatmap":
+			c.showActivityHeatmap()
+		case "🗂️  Time by Category":
+			c.showTimeByCategory()
+		case "🕰️  Day Timeline":
+			c.showDayTimeline()
+		case "🔋 Energy vs Focus":
+			c.showEnergyAnalysis()
+		case "🗓️  Weekly Review":
+			c.showWeeklyReview()
+		case "🏅 All-Time Stats":
+			c.showLifetimeStats()
+		case "🔙 Back to Main Menu":
+			return
+		}
+	}
 }
 
 func (c *FocusForgeCLI) showSpotifyIntegration() {
@@ -812,34 +2270,104 @@ func (c *FocusForgeCLI) showSpotifyIntegration() {
 	fmt.Println()
 }
 
-func (c *FocusForgeCLI) showSettings() {
+func (c *FocusForgeCLI) showSettings(parent string) {
+	path := crumb(parent, "Settings")
 	for {
+		renderHeader(path)
+		
 		menuItems := []string{
 			"🔧 API Configuration",
+			"🩺 Check All Backends",
 			"👤 User Settings",
+			"🗂️  Categories",
 			"🎨 Display Options",
+			"🔕 Do Not Disturb",
+			"🔒 Strict Focus",
+			"🔗 Auto-Chain Blocks",
+			"📋 Session Checklist",
+			"😊 Mood After Sessions",
+			"🎉 Celebrations",
+			"🌴 Vacation Mode",
+			"🌧️  Focus Soundscape",
+			"🔔 Bell & Sounds",
+			"⏱️  Time Budget Warnings",
+			"⌨️  Keybindings",
+			"💤 Idle Detection",
+			"📝 Debug Logging",
+			"📈 Client Metrics",
+			"🔁 Retries",
+			"📤 Export Settings",
+			"📥 Import Settings",
+			"🧹 Clear Local Data",
 			"🔙 Back to Main Menu",
 		}
 		
 		prompt := promptui.Select{
-			Label: "Settings - What would you like to do?",
+			Label: "What would you like to do?",
 			Items: menuItems,
 			Size:  10,
 		}
 		
 		_, result, err := prompt.Run()
+		if wentBack(err) {
+			return
+		}
 		if err != nil {
 			color.Red("Error selecting menu item: %v", err)
 			return
 		}
 		
+		if !isBackItem(result) {
+			renderHeader(crumb(path, menuLabel(result)))
+		}
+		
 		switch result {
 		case "🔧 API Configuration":
 			c.showAPIConfig()
+		case "🩺 Check All Backends":
+			c.showBackendHealth()
 		case "👤 User Settings":
 			c.showUserSettings()
+		case "🗂️  Categories":
+			c.showCategorySettings()
 		case "🎨 Display Options":
 			c.showDisplayOptions()
+		case "🔕 Do Not Disturb":
+			c.toggleDoNotDisturb()
+		case "🔒 Strict Focus":
+			c.toggleStrictFocus()
+		case "🔗 Auto-Chain Blocks":
+			c.showAutoChainSettings()
+		case "📋 Session Checklist":
+			c.showChecklistSettings()
+		case "😊 Mood After Sessions":
+			c.toggleSessionMood()
+		case "🎉 Celebrations":
+			c.showCelebrationSettings()
+		case "🌴 Vacation Mode":
+			c.showVacationSettings()
+		case "🌧️  Focus Soundscape":
+			c.showSoundscapeSettings()
+		case "🔔 Bell & Sounds":
+			c.showBellSettings()
+		case "⏱️  Time Budget Warnings":
+			c.showBudgetSettings()
+		case "⌨️  Keybindings":
+			c.showKeybindingSettings()
+		case "💤 Idle Detection":
+			c.showIdleSettings()
+		case "📝 Debug Logging":
+			c.showLoggingSettings()
+		case "📈 Client Metrics":
+			c.showClientMetrics()
+		case "🔁 Retries":
+			c.showRetrySettings()
+		case "📤 Export Settings":
+			c.exportSettings()
+		case "📥 Import Settings":
+			c.importSettings()
+		case "🧹 Clear Local Data":
+			c.clearLocalData()
 		case "🔙 Back to Main Menu":
 			return
 		}
@@ -850,8 +2378,22 @@ func (c *FocusForgeCLI) showAPIConfig() {
 	color.Cyan("🔧 API Configuration")
 	fmt.Println()
 	
-	fmt.Printf("Current API URL: %s\n", c.apiURL)
-	fmt.Printf("Current User ID: %s\n", c.userID)
+	fmt.Printf("Current API URL: %s (from %s)\n", c.apiURL, c.sources["api_url"])
+	fmt.Printf("Current User ID: %s (from %s)\n", c.userID, c.sources["user_id"])
+	if c.token != "" {
+		fmt.Printf("API Token: %s (from %s)\n", maskSecret(c.token), c.sources["token"])
+	} else {
+		fmt.Println("API Token: not set")
+	}
+	fmt.Println()
+	
+	c.printEndpointOverrides()
+	
+	color.Cyan("Settings precedence (highest first):")
+	fmt.Println("  1. Command-line flags: --api-url, --user-id, --token")
+	fmt.Printf("  2. Environment variables: %s, %s, %s\n", envAPIURL, envUserID, envToken)
+	fmt.Println("  3. Config file: ~/.focusforge/config.json")
+	fmt.Println("  4. Built-in defaults")
 	fmt.Println()
 	
 	// TODO: Allow changing API URL
@@ -860,24 +2402,445 @@ func (c *FocusForgeCLI) showAPIConfig() {
 }
 
 func (c *FocusForgeCLI) showUserSettings() {
-	color.Cyan("👤 User Settings")
+	for {
+		color.Cyan("👤 User Settings")
+		fmt.Println()
+
+		// Items show their current values, so name them to switch on below
+		thresholdItem := fmt.Sprintf("⚠️  Confirm bulk operations over: %d items", c.config.ConfirmThreshold)
+		listLimitItem := fmt.Sprintf("📋 Items to show in lists: %d", c.config.listLimit())
+		rateLimitItem := fmt.Sprintf("🚦 Max requests per second: %s", rateLimitLabel(c.config.RateLimit))
+		sessionLengthItem := fmt.Sprintf("⏳ Session length: %s", c.config.SessionLength)
+		startBufferItem := fmt.Sprintf("🧘 Get-ready countdown: %s", startBufferLabel(c.config.StartBuffer))
+		duplicateItem := fmt.Sprintf("👯 Warn about duplicate task titles: %s", onOff(c.config.DuplicateTitleCheck))
+		updateCheckItem := fmt.Sprintf("🆕 Check for updates at startup: %s", onOff(c.config.UpdateCheck))
+		menuItems := []string{
+			thresholdItem,
+			listLimitItem,
+			rateLimitItem,
+			sessionLengthItem,
+			startBufferItem,
+			duplicateItem,
+			updateCheckItem,
+			"🔙 Back",
+		}
+
+		prompt := promptui.Select{
+			Label: "Select an option to change",
+			Items: menuItems,
+			Size:  10,
+		}
+
+		_, choice, err := prompt.Run()
+		if err != nil || choice == "🔙 Back" {
+			return
+		}
+
+		switch choice {
+		case thresholdItem:
+			value, ok := promptInt("Ask for confirmation when an operation affects more than N items", c.config.ConfirmThreshold, 0, 10000)
+			if !ok {
+				continue
+			}
+			c.config.ConfirmThreshold = value
+		case listLimitItem:
+			value, ok := promptInt("How many items list views fetch by default", c.config.listLimit(), 1, maxListLimit)
+			if !ok {
+				continue
+			}
+			c.config.ListLimit = value
+		case rateLimitItem:
+			value, ok := promptInt("Most requests to send per second (0 for no limit)", c.config.RateLimit, 0, 1000)
+			if !ok {
+				continue
+			}
+			c.config.RateLimit = value
+			if c.apiClient != nil {
+				c.apiClient.SetRateLimit(value)
+			}
+		case sessionLengthItem:
+			lengthPrompt := promptui.Select{
+				Label:     "New sessions last (prompt asks each time, task uses its full duration, preset is one Pomodoro)",
+				Items:     sessionLengths,
+				CursorPos: indexOf(sessionLengths, c.config.SessionLength),
+			}
+			_, length, err := lengthPrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.SessionLength = length
+		case startBufferItem:
+			value, ok := promptInt("Seconds to count down before a session starts (0 to start right away)", c.config.StartBuffer, 0, maxStartBuffer)
+			if !ok {
+				continue
+			}
+			c.config.StartBuffer = value
+		case duplicateItem:
+			c.config.DuplicateTitleCheck = !c.config.DuplicateTitleCheck
+		case updateCheckItem:
+			c.config.UpdateCheck = !c.config.UpdateCheck
+		}
+
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Settings saved")
+		}
+		fmt.Println()
+	}
+}
+
+// rateLimitLabel renders the request rate limit for menus
+func rateLimitLabel(perSecond int) string {
+	if perSecond <= 0 {
+		return "unlimited"
+	}
+	return strconv.Itoa(perSecond)
+}
+
+// promptInt asks for a whole number within [min, max], returning false if
+// the prompt was cancelled
+func promptInt(label string, current, min, max int) (int, bool) {
+	prompt := promptui.Prompt{
+		Label:   label,
+		Default: strconv.Itoa(current),
+		Validate: func(input string) error {
+			value, err := strconv.Atoi(strings.TrimSpace(input))
+			if err != nil || value < min || value > max {
+				return fmt.Errorf("enter a number between %d and %d", min, max)
+			}
+			return nil
+		},
+	}
+	input, err := prompt.Run()
+	if err != nil {
+		return 0, false
+	}
+	value, _ := strconv.Atoi(strings.TrimSpace(input))
+	return value, true
+}
+
+// promptListLimit asks how many items a list view should fetch, offering
+// the configured default
+func promptListLimit(current int) (int, bool) {
+	return promptInt("How many to show", current, 1, maxListLimit)
+}
+
+// offerLoadMore asks whether to fetch the next page of a list once loaded
+// items have been fetched and the backend says there are more
+func offerLoadMore(loaded int) bool {
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("%d loaded - there are more", loaded),
+		Items: []string{"⬇️  Load More", "✓ Done"},
+	}
+	idx, _, err := prompt.Run()
+	return err == nil && idx == 0
+}
+
+// confirmBulk guards operations that touch many items. When count exceeds
+// the configured threshold the user must type YES to proceed.
+func (c *FocusForgeCLI) confirmBulk(count int) bool {
+	if count <= c.config.ConfirmThreshold {
+		return true
+	}
+
+	color.Yellow("⚠️  This will affect %d items.", count)
+	prompt := promptui.Prompt{
+		Label: "Type YES to proceed",
+	}
+	answer, err := prompt.Run()
+	if err != nil || strings.TrimSpace(answer) != "YES" {
+		color.Yellow("Cancelled")
+		fmt.Println()
+		return false
+	}
+	return true
+}
+
+func (c *FocusForgeCLI) showDisplayOptions() {
+	for {
+		color.Cyan("🎨 Display Options")
+		fmt.Println()
+
+		menuItems := []string{
+			fmt.Sprintf("🧹 Clear screen between menus: %s", onOff(c.config.ClearScreen)),
+			fmt.Sprintf("🚀 Welcome banner: %s", onOff(c.config.ShowBanner)),
+			fmt.Sprintf("🌍 Timezone: %s", c.config.Timezone),
+			fmt.Sprintf("⏲️  Round durations to: %s", c.config.DurationRounding),
+			"🏷️  Intensity labels",
+			fmt.Sprintf("🌈 Color theme: %s", c.config.Theme),
+			"🔙 Back",
+		}
+
+		prompt := promptui.Select{
+			Label: "Select an option to change",
+			Items: menuItems,
+			Size:  10,
+		}
+
+		idx, _, err := prompt.Run()
+		if err != nil || idx == len(menuItems)-1 {
+			return
+		}
+
+		switch idx {
+		case 0:
+			c.config.ClearScreen = !c.config.ClearScreen
+		case 1:
+			c.config.ShowBanner = !c.config.ShowBanner
+		case 2:
+			tzPrompt := promptui.Prompt{
+				Label:   "Timezone (e.g. Local, UTC, America/New_York)",
+				Default: c.config.Timezone,
+				Validate: func(input string) error {
+					_, err := loadLocation(strings.TrimSpace(input))
+					return err
+				},
+			}
+			tz, err := tzPrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.Timezone = strings.TrimSpace(tz)
+			c.applyTimezone()
+		case 3:
+			roundingPrompt := promptui.Select{
+				Label:     "Round focus durations to",
+				Items:     durationRoundings,
+				CursorPos: indexOf(durationRoundings, c.config.DurationRounding),
+			}
+			_, rounding, err := roundingPrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.DurationRounding = rounding
+		case 4:
+			c.showIntensityLabelSettings()
+			continue
+		case 5:
+			themePrompt := promptui.Select{
+				Label:     "Color theme (colorblind avoids red/green and adds symbols)",
+				Items:     themes,
+				CursorPos: indexOf(themes, c.config.Theme),
+			}
+			_, theme, err := themePrompt.Run()
+			if err != nil {
+				continue
+			}
+			c.config.Theme = theme
+			c.applyTheme()
+		}
+
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Settings saved")
+		}
+		fmt.Println()
+	}
+}
+
+// applyTimezone resolves the configured display timezone, falling back to
+// Local with a warning if the name is not recognized
+func (c *FocusForgeCLI) applyTimezone() {
+	loc, err := loadLocation(c.config.Timezone)
+	if err != nil {
+		color.Yellow("⚠️  %v - showing times in Local instead", err)
+		loc = time.Local
+	}
+	c.location = loc
+}
+
+// breadcrumbSeparator joins the levels of a breadcrumb trail
+const breadcrumbSeparator = " ▸ "
+
+// crumb appends a level to a breadcrumb trail
+func crumb(parent, name string) string {
+	return parent + breadcrumbSeparator + name
+}
+
+// renderHeader shows where the user is in the menu tree, e.g.
+// "Main ▸ Tasks ▸ Edit Task"
+func renderHeader(breadcrumb string) {
+	color.New(color.FgHiBlack).Println(breadcrumb)
+}
+
+// menuLabel strips the emoji prefix from a menu item for use in breadcrumbs
+func menuLabel(item string) string {
+	return strings.TrimLeftFunc(item, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// isBackItem reports whether a menu item navigates back up the tree
+func isBackItem(item string) bool {
+	return strings.HasPrefix(item, "🔙")
+}
+
+// onOff renders a boolean setting for menus
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
+
+func (c *FocusForgeCLI) toggleDoNotDisturb() {
+	color.Cyan("🔕 Do Not Disturb")
 	fmt.Println()
-	
-	// TODO: Show user settings
-	color.Yellow("Feature coming soon!")
+
+	state := "off"
+	if c.config.DoNotDisturb {
+		state = "on"
+	}
+	fmt.Printf("Enable OS Do Not Disturb during focus sessions: %s\n", state)
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label: "Enable Do Not Disturb during focus sessions?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	c.config.DoNotDisturb = choice == "Yes"
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+		fmt.Println()
+		return
+	}
+
+	color.Green("✓ Settings saved")
 	fmt.Println()
 }
 
-func (c *FocusForgeCLI) showDisplayOptions() {
-	color.Cyan("🎨 Display Options")
+func (c *FocusForgeCLI) showSoundscapeSettings() {
+	color.Cyan("🌧️  Focus Soundscape")
 	fmt.Println()
-	
-	// TODO: Show display options
-	color.Yellow("Feature coming soon!")
+
+	current := c.config.Soundscape
+	if current == "" {
+		current = "off"
+	}
+	fmt.Printf("Current soundscape: %s (volume %d%%)\n", current, c.config.SoundVolume)
+
+	dir, _ := soundsDir()
+	names := availableSoundscapes()
+	if len(names) == 0 {
+		color.Yellow("No sound files found. Add .wav or .mp3 files (e.g. rain.wav, white-noise.mp3) to:")
+		fmt.Printf("  %s\n", dir)
+		fmt.Println()
+		return
+	}
+	fmt.Println()
+
+	items := append([]string{"off"}, names...)
+	soundPrompt := promptui.Select{
+		Label:     "Ambient sound during focus sessions",
+		Items:     items,
+		CursorPos: indexOf(items, current),
+	}
+	_, choice, err := soundPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	if choice == "off" {
+		c.config.Soundscape = ""
+	} else {
+		c.config.Soundscape = choice
+
+		if !volumeSupported() {
+			dimmed.Println("Volume is not supported by the built-in Windows player - install ffplay to control it.")
+		}
+		volumePrompt := promptui.Prompt{
+			Label:   "Volume (0-100)",
+			Default: strconv.Itoa(c.config.SoundVolume),
+			Validate: func(input string) error {
+				volume, err := strconv.Atoi(strings.TrimSpace(input))
+				if err != nil || volume < 0 || volume > 100 {
+					return fmt.Errorf("volume must be between 0 and 100")
+				}
+				return nil
+			},
+		}
+		if volumeStr, err := volumePrompt.Run(); err == nil {
+			c.config.SoundVolume, _ = strconv.Atoi(strings.TrimSpace(volumeStr))
+		}
+	}
+
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+}
+
+func (c *FocusForgeCLI) showBudgetSettings() {
+	color.Cyan("⏱️  Time Budget Warnings")
+	fmt.Println()
+
+	fmt.Printf("Warn before going over a task's estimate: %s\n", onOff(c.config.BudgetWarnings))
+	fmt.Printf("Allowed overrun before warning: %d%%\n", c.config.BudgetThreshold)
+	fmt.Println()
+
+	enablePrompt := promptui.Select{
+		Label: "Warn when a session would exceed the task's estimate?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := enablePrompt.Run()
+	if err != nil {
+		return
+	}
+	c.config.BudgetWarnings = choice == "Yes"
+
+	if c.config.BudgetWarnings {
+		thresholdPrompt := promptui.Prompt{
+			Label:   "Allowed overrun before warning (% of estimate)",
+			Default: strconv.Itoa(c.config.BudgetThreshold),
+			Validate: func(input string) error {
+				percent, err := strconv.Atoi(strings.TrimSpace(input))
+				if err != nil || percent < 0 || percent > 500 {
+					return fmt.Errorf("threshold must be between 0 and 500")
+				}
+				return nil
+			},
+		}
+		if thresholdStr, err := thresholdPrompt.Run(); err == nil {
+			c.config.BudgetThreshold, _ = strconv.Atoi(strings.TrimSpace(thresholdStr))
+		}
+	}
+
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
 	fmt.Println()
 }
 
 func (c *FocusForgeCLI) exit() {
+	if session := c.session(); session != nil {
+		color.Yellow("⚠️  A focus session on \"%s\" is still running.", session.TaskTitle)
+		confirmPrompt := promptui.Select{
+			Label: "Quit anyway? The running session will be ended",
+			Items: []string{"No, keep going", "Yes, end session and quit"},
+		}
+		_, choice, err := confirmPrompt.Run()
+		if err != nil || choice != "Yes, end session and quit" {
+			return
+		}
+
+		if !c.finishSession(false) {
+			// Don't leave the timer goroutine running behind the farewell
+			session.stop()
+			color.Yellow("⚠️  The session could not be ended on the server and may still show as active.")
+		}
+	}
+	
 	color.Yellow("👋 Thanks for using FocusForge CLI!")
 	color.Yellow("Keep up the great work on your productivity journey!")
 	fmt.Println()
@@ -889,5 +2852,31 @@ func (c *FocusForgeCLI) exit() {
 	}
 	fmt.Println()
 	
-	c.isRunning = false
+	c.stopRunning()
+}
+
+// errorMessage returns the backend's error for a failed response, or a
+// generic explanation when the backend did not say what went wrong
+func errorMessage(resp apiResponse) string {
+	if msg := strings.TrimSpace(resp.errorText()); msg != "" {
+		return msg
+	}
+	return "the server reported a failure but gave no details"
+}
+
+// waitForEnter pauses until the user presses Enter
+func waitForEnter() {
+	fmt.Println("Press Enter to continue...")
+	bufio.NewReader(os.Stdin).ReadString('\n')
+}
+
+// indexOf returns the position of s in items, or 0 if it is not present so
+// it can be used directly as a default cursor position
+func indexOf(items []string, s string) int {
+	for i, item := range items {
+		if item == s {
+			return i
+		}
+	}
+	return 0
 }
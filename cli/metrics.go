@@ -0,0 +1,245 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// endpointStats accumulates the requests made to one endpoint
+type endpointStats struct {
+	Endpoint string
+	Requests int
+	Errors   int
+	Total    time.Duration
+	Min      time.Duration
+	Max      time.Duration
+}
+
+// average returns the mean latency of the endpoint's requests
+func (s endpointStats) average() time.Duration {
+	if s.Requests == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Requests)
+}
+
+// errorRate returns the percentage of the endpoint's requests that failed
+func (s endpointStats) errorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Requests) * 100
+}
+
+// clientMetrics collects per-endpoint request counts, latencies and errors
+// in memory. It is safe for concurrent use.
+type clientMetrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointStats
+	since     time.Time
+}
+
+func newClientMetrics() *clientMetrics {
+	return &clientMetrics{endpoints: map[string]*endpointStats{}, since: time.Now()}
+}
+
+// record adds one request to endpoint's stats
+func (m *clientMetrics) record(endpoint string, elapsed time.Duration, failed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.endpoints[endpoint]
+	if !ok {
+		s = &endpointStats{Endpoint: endpoint, Min: elapsed}
+		m.endpoints[endpoint] = s
+	}
+	s.Requests++
+	if failed {
+		s.Errors++
+	}
+	s.Total += elapsed
+	if elapsed < s.Min {
+		s.Min = elapsed
+	}
+	if elapsed > s.Max {
+		s.Max = elapsed
+	}
+}
+
+// snapshot returns a copy of the stats, busiest endpoint first, and when
+// collection started
+func (m *clientMetrics) snapshot() ([]endpointStats, time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := make([]endpointStats, 0, len(m.endpoints))
+	for _, s := range m.endpoints {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Requests != stats[j].Requests {
+			return stats[i].Requests > stats[j].Requests
+		}
+		return stats[i].Endpoint < stats[j].Endpoint
+	})
+	return stats, m.since
+}
+
+// reset discards everything collected so far
+func (m *clientMetrics) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.endpoints = map[string]*endpointStats{}
+	m.since = time.Now()
+}
+
+// metricsTransport is an http.RoundTripper that records every request it
+// passes on to next while metrics are set. It is installed once per client
+// and switched on and off through metrics, since the transport can't be
+// swapped while requests are in flight.
+type metricsTransport struct {
+	next    http.RoundTripper
+	metrics atomic.Pointer[clientMetrics]
+}
+
+// RoundTrip sends req and, when recording, notes how long the backend took
+// to answer. Transport errors and 4xx/5xx responses count as errors.
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	m := t.metrics.Load()
+	if m == nil {
+		return t.next.RoundTrip(req)
+	}
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	m.record(endpointKey(req), time.Since(start), err != nil || resp.StatusCode >= 400)
+	return resp, err
+}
+
+// endpointKey names the endpoint req was sent to, replacing IDs in the path
+// with ":id" so requests for different tasks or sessions are grouped
+func endpointKey(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if looksLikeID(segment) {
+			segments[i] = ":id"
+		}
+	}
+	return req.Method + " /" + strings.Join(segments, "/")
+}
+
+// looksLikeID reports whether a path segment is an identifier rather than
+// a fixed part of the route: all digits, or long and containing a digit
+func looksLikeID(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	digits := 0
+	for _, r := range segment {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	return digits == len(segment) || (len(segment) >= 8 && digits > 0)
+}
+
+// SetMetrics records every request in m. Pass nil to stop recording. It is
+// safe to call while requests are being sent.
+func (c *APIClient) SetMetrics(m *clientMetrics) {
+	c.transport.metrics.Store(m)
+}
+
+// formatLatency renders a latency in whole milliseconds
+func formatLatency(d time.Duration) string {
+	return fmt.Sprintf("%d ms", d.Milliseconds())
+}
+
+// printMetricsTable lists stats as a table, one endpoint per row
+func printMetricsTable(stats []endpointStats) {
+	width := len("Endpoint")
+	for _, s := range stats {
+		if len(s.Endpoint) > width {
+			width = len(s.Endpoint)
+		}
+	}
+	if max := terminalWidth() - 44; width > max && max > 12 {
+		width = max
+	}
+
+	fmt.Printf("%-*s  %5s  %8s  %8s  %8s  %6s\n", width, "Endpoint", "Count", "Min", "Avg", "Max", "Errors")
+	fmt.Printf("%s  %s  %s  %s  %s  %s\n", strings.Repeat("-", width), "-----", "--------", "--------", "--------", "------")
+	for _, s := range stats {
+		errors := fmt.Sprintf("%5.1f%%", s.errorRate())
+		if s.Errors > 0 {
+			errors = color.RedString(errors)
+		}
+		fmt.Printf("%s  %5d  %8s  %8s  %8s  %s\n", padRight(fitWidth(s.Endpoint, width), width), s.Requests,
+			formatLatency(s.Min), formatLatency(s.average()), formatLatency(s.Max), errors)
+	}
+}
+
+func (c *FocusForgeCLI) showClientMetrics() {
+	for {
+		color.Cyan("📈 Client Metrics")
+		fmt.Println()
+
+		fmt.Println("Counts the requests sent to each backend endpoint, how long they took")
+		fmt.Println("and how many failed, to find slow endpoints. Nothing leaves this machine.")
+		fmt.Println()
+		fmt.Printf("Metrics: %s\n", onOff(c.config.Metrics))
+
+		stats, since := c.metrics.snapshot()
+		if len(stats) == 0 {
+			fmt.Println()
+			color.Yellow("No requests recorded yet.")
+		} else {
+			fmt.Printf("Since: %s\n", c.formatTime(since, displayTimeLayout))
+			fmt.Println()
+			printMetricsTable(stats)
+		}
+		fmt.Println()
+
+		toggle := "✅ Enable"
+		if c.config.Metrics {
+			toggle = "🚫 Disable"
+		}
+		prompt := promptui.Select{
+			Label: "What would you like to do?",
+			Items: []string{"🔄 Refresh", toggle, "🧹 Reset", "🔙 Back"},
+		}
+		_, choice, err := prompt.Run()
+		if err != nil || choice == "🔙 Back" {
+			return
+		}
+
+		switch choice {
+		case "✅ Enable", "🚫 Disable":
+			c.config.Metrics = !c.config.Metrics
+			if c.apiClient != nil {
+				if c.config.Metrics {
+					c.apiClient.SetMetrics(c.metrics)
+				} else {
+					c.apiClient.SetMetrics(nil)
+				}
+			}
+			if err := saveConfig(c.config); err != nil {
+				color.Red("❌ Failed to save settings: %v", err)
+			} else {
+				color.Green("✓ Settings saved")
+			}
+			fmt.Println()
+		case "🧹 Reset":
+			c.metrics.reset()
+			color.Green("✓ Metrics reset")
+			fmt.Println()
+		}
+	}
+}
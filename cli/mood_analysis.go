@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// moodAnalysisDays is the default analysis window
+const moodAnalysisDays = 30
+
+// dateLayout is how dates are entered
+const dateLayout = "2006-01-02"
+
+// parseDate parses a YYYY-MM-DD date as midnight in loc
+func parseDate(s string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(dateLayout, strings.TrimSpace(s), loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("enter a date like %s", time.Now().Format(dateLayout))
+	}
+	return t, nil
+}
+
+// promptDateRange asks for a start and end date, defaulting to the last
+// moodAnalysisDays days. The range it returns runs from the start of the
+// first day to the start of the day after the last.
+func (c *FocusForgeCLI) promptDateRange() (time.Time, time.Time, bool) {
+	loc := c.location
+	if loc == nil {
+		loc = time.Local
+	}
+	today := time.Now().In(loc)
+	validate := func(input string) error {
+		_, err := parseFuzzyDate(input, today)
+		return err
+	}
+
+	for {
+		fromPrompt := promptui.Prompt{
+			Label:    "From (YYYY-MM-DD, or e.g. \"last week\")",
+			Default:  today.AddDate(0, 0, -(moodAnalysisDays - 1)).Format(dateLayout),
+			Validate: validate,
+		}
+		fromStr, err := fromPrompt.Run()
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+
+		toPrompt := promptui.Prompt{
+			Label:    "To (YYYY-MM-DD, or e.g. \"yesterday\")",
+			Default:  today.Format(dateLayout),
+			Validate: validate,
+		}
+		toStr, err := toPrompt.Run()
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+
+		from, _ := parseFuzzyDate(fromStr, today)
+		to, _ := parseFuzzyDate(toStr, today)
+		if to.Before(from) {
+			color.Red("❌ The start date must be on or before the end date")
+			fmt.Println()
+			continue
+		}
+		return from, to.AddDate(0, 0, 1), true
+	}
+}
+
+// feelingStats aggregates the logs for one feeling
+type feelingStats struct {
+	feeling   string
+	count     int
+	intensity int
+	rated     int
+}
+
+func (c *FocusForgeCLI) showMoodAnalysis() {
+	color.Cyan("🔍 Mood Analysis")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load mood logs")
+		fmt.Println()
+		return
+	}
+
+	from, to, ok := c.promptDateRange()
+	if !ok {
+		return
+	}
+
+	stop := startSpinner("Loading mood logs")
+	resp, err := c.apiClient.GetMoodLogsRange(from, to)
+	stop()
+	if err != nil {
+		color.Red("❌ Failed to load mood logs: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to load mood logs: %s", errorMessage(resp))
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	byFeeling := map[string]*feelingStats{}
+	total, rated, intensitySum := 0, 0, 0
+	var levels [3]int
+	for _, entry := range resp.MoodLogs {
+		// Older backends ignore the range, so filter here as well
+		if t, ok := parseTimestamp(entry.Timestamp); ok && (t.Before(from) || !t.Before(to)) {
+			continue
+		}
+		stats, ok := byFeeling[entry.Feeling]
+		if !ok {
+			stats = &feelingStats{feeling: entry.Feeling}
+			byFeeling[entry.Feeling] = stats
+		}
+		stats.count++
+		total++
+		if entry.Intensity > 0 {
+			stats.intensity += entry.Intensity
+			stats.rated++
+			intensitySum += entry.Intensity
+			rated++
+			switch {
+			case entry.Intensity <= 3:
+				levels[0]++
+			case entry.Intensity <= 6:
+				levels[1]++
+			default:
+				levels[2]++
+			}
+		}
+	}
+
+	last := to.AddDate(0, 0, -1)
+	fmt.Printf("%s to %s\n\n", c.formatTime(from, "Jan 2 2006"), c.formatTime(last, "Jan 2 2006"))
+	if total == 0 {
+		color.Yellow("No moods logged in this range.")
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	fmt.Printf("  Entries: %d\n", total)
+	if rated > 0 {
+		avg := float64(intensitySum) / float64(rated)
+		fmt.Printf("  Average intensity: %s\n", intensityColor(int(avg+0.5)).Sprintf("%.1f/10", avg))
+		fmt.Printf("  Intensity: %s low · %s medium · %s high\n",
+			intensityColor(1).Sprint(levels[0]), intensityColor(5).Sprint(levels[1]), intensityColor(10).Sprint(levels[2]))
+	}
+	fmt.Println()
+
+	rows := make([]*feelingStats, 0, len(byFeeling))
+	width := 0
+	for _, stats := range byFeeling {
+		rows = append(rows, stats)
+		if len(stats.feeling) > width {
+			width = len(stats.feeling)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].count != rows[j].count {
+			return rows[i].count > rows[j].count
+		}
+		return rows[i].feeling < rows[j].feeling
+	})
+
+	color.Cyan("Distribution:")
+	for _, row := range rows {
+		share := float64(row.count) * 100 / float64(total)
+		avg := "-"
+		if row.rated > 0 {
+			avg = fmt.Sprintf("avg %.1f", float64(row.intensity)/float64(row.rated))
+		}
+		fmt.Printf("  %-*s %3d  %5.1f%%  %s\n", width, row.feeling, row.count, share, avg)
+	}
+	fmt.Println()
+	waitForEnter()
+}
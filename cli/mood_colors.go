@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// intensityColor returns the color for a mood intensity: blue for low
+// (1-3), yellow for medium (4-6), red for high (7-10), and a neutral color
+// when the intensity is unspecified. The color-blind safe theme uses magenta
+// for high.
+func intensityColor(intensity int) *color.Color {
+	if colorBlindSafe && intensity > 6 {
+		return color.New(color.FgMagenta)
+	}
+	switch {
+	case intensity <= 0:
+		return color.New(color.FgWhite)
+	case intensity <= 3:
+		return color.New(color.FgBlue)
+	case intensity <= 6:
+		return color.New(color.FgYellow)
+	default:
+		return color.New(color.FgRed)
+	}
+}
+
+// intensityBar renders an intensity as a colored bar ten cells wide
+func intensityBar(intensity int) string {
+	if intensity < 0 {
+		intensity = 0
+	}
+	if intensity > 10 {
+		intensity = 10
+	}
+	return intensityColor(intensity).Sprint(strings.Repeat("█", intensity)) + strings.Repeat("·", 10-intensity)
+}
+
+// printMoodLog renders one mood log entry on a single line
+func (c *FocusForgeCLI) printMoodLog(entry *MoodLog) {
+	when := c.formatTimestamp(entry.Timestamp)
+	if t, ok := parseTimestamp(entry.Timestamp); ok {
+		when = c.formatTime(t, "Jan 2 15:04")
+	}
+	fmt.Printf("  %-12s %-12s %s %s", when, entry.Feeling, intensityBar(entry.Intensity), c.formatIntensity(entry.Intensity))
+	if entry.Note != "" {
+		fmt.Printf("  %s", entry.Note)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// moodImportRow is a validated row from a mood CSV
+type moodImportRow struct {
+	line int
+	req  MoodLogRequest
+}
+
+// moodImportError describes a row that could not be imported
+type moodImportError struct {
+	line int
+	err  error
+}
+
+func (c *FocusForgeCLI) importMoods() {
+	color.Cyan("📥 Import Moods")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot import moods")
+		fmt.Println()
+		return
+	}
+
+	fmt.Println("Expected CSV columns: timestamp, feeling, intensity, note")
+	fmt.Println("A header row is optional. Timestamps may be ISO 8601 or YYYY-MM-DD HH:MM.")
+	fmt.Println()
+
+	pathPrompt := promptui.Prompt{
+		Label: "CSV file",
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("file path cannot be empty")
+			}
+			return nil
+		},
+	}
+	path, err := pathPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	file, err := os.Open(strings.TrimSpace(path))
+	if err != nil {
+		color.Red("❌ Failed to open file: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	rows, badRows := parseMoodCSV(file)
+	file.Close()
+
+	if len(rows) == 0 {
+		color.Yellow("No valid rows to import.")
+	} else {
+		if !c.confirmBulk(len(rows)) {
+			return
+		}
+		color.Yellow("Importing %d moods...", len(rows))
+	}
+
+	imported := 0
+	for _, row := range rows {
+		resp, err := c.apiClient.LogMood(row.req)
+		if err != nil {
+			badRows = append(badRows, moodImportError{line: row.line, err: err})
+			continue
+		}
+		if !resp.Success {
+			badRows = append(badRows, moodImportError{line: row.line, err: fmt.Errorf("%s", errorMessage(resp))})
+			continue
+		}
+		imported++
+	}
+
+	fmt.Println()
+	color.Green("✓ Imported %d moods", imported)
+	if len(badRows) > 0 {
+		color.Red("❌ %d rows were skipped:", len(badRows))
+		for _, bad := range badRows {
+			fmt.Printf("  Line %d: %v\n", bad.line, bad.err)
+		}
+	}
+	fmt.Println()
+	waitForEnter()
+}
+
+// parseMoodCSV reads timestamp, feeling, intensity, note rows, returning the
+// valid ones and a per-line error for each row that was rejected
+func parseMoodCSV(r io.Reader) ([]moodImportRow, []moodImportError) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []moodImportRow
+	var badRows []moodImportError
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Malformed rows can be skipped, but an I/O error ends the import
+			parseErr, ok := err.(*csv.ParseError)
+			if !ok {
+				badRows = append(badRows, moodImportError{err: err})
+				break
+			}
+			badRows = append(badRows, moodImportError{line: parseErr.Line, err: parseErr.Err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "timestamp") {
+			continue
+		}
+
+		req, err := parseMoodRecord(record)
+		if err != nil {
+			badRows = append(badRows, moodImportError{line: line, err: err})
+			continue
+		}
+		rows = append(rows, moodImportRow{line: line, req: req})
+	}
+
+	return rows, badRows
+}
+
+// parseMoodRecord validates a single CSV record
+func parseMoodRecord(record []string) (MoodLogRequest, error) {
+	if len(record) < 2 {
+		return MoodLogRequest{}, fmt.Errorf("expected at least timestamp and feeling, got %d columns", len(record))
+	}
+
+	timestamp, ok := parseTimestamp(strings.TrimSpace(record[0]))
+	if !ok {
+		return MoodLogRequest{}, fmt.Errorf("invalid timestamp %q", record[0])
+	}
+
+	feeling := strings.ToLower(strings.TrimSpace(record[1]))
+	if feeling == "" {
+		return MoodLogRequest{}, fmt.Errorf("feeling cannot be empty")
+	}
+
+	intensity := 0
+	if len(record) > 2 && strings.TrimSpace(record[2]) != "" {
+		value, err := strconv.Atoi(strings.TrimSpace(record[2]))
+		if err != nil || value < 1 || value > 10 {
+			return MoodLogRequest{}, fmt.Errorf("intensity must be between 1 and 10, got %q", record[2])
+		}
+		intensity = value
+	}
+
+	note := ""
+	if len(record) > 3 {
+		note = strings.TrimSpace(record[3])
+	}
+
+	return MoodLogRequest{
+		Feeling:   feeling,
+		Intensity: intensity,
+		Note:      note,
+		Timestamp: timestamp.Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// moodUndoWindow is how long a just-logged mood can be undone
+const moodUndoWindow = 5 * time.Second
+
+// offerMoodUndo gives the user a few seconds to press U and delete a mood
+// they just logged. Any other key, or letting the time run out, keeps it.
+func (c *FocusForgeCLI) offerMoodUndo(log *MoodLog) {
+	if log == nil || log.ID == "" || c.apiClient == nil {
+		return
+	}
+
+	undo := false
+	for left := moodUndoWindow; left > 0; left -= time.Second {
+		fmt.Printf("\r[U]ndo (%ds) ", int(left.Seconds()))
+		key, pressed, err := readKeyWithin(time.Second)
+		if errors.Is(err, errKeyWaitUnsupported) {
+			// No way to time out here, so just ask
+			fmt.Print("\r[U]ndo / any other key to keep: ")
+			key, err = readKey()
+			pressed = err == nil
+		}
+		if err != nil || pressed {
+			undo = pressed && (key == 'u' || key == 'U')
+			break
+		}
+	}
+	fmt.Print("\r\033[K")
+
+	if !undo {
+		return
+	}
+
+	resp, err := c.apiClient.DeleteMoodLog(log.ID)
+	if err != nil {
+		color.Red("❌ Failed to undo mood: %v - the entry was kept", err)
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to undo mood: %s - the entry was kept", errorMessage(resp))
+		return
+	}
+	appLog.Info("mood undone", "mood", log.ID)
+	color.Yellow("↩️  Mood entry removed")
+}
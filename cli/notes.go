@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// printTaskNotes shows a task's notes, newest first
+func (c *FocusForgeCLI) printTaskNotes(taskID string) {
+	resp, err := c.apiClient.GetTaskNotes(taskID)
+	if err != nil {
+		color.Yellow("⚠️  Could not load notes: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Yellow("⚠️  Could not load notes: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+	if len(resp.Notes) == 0 {
+		return
+	}
+
+	notes := resp.Notes
+	sort.SliceStable(notes, func(i, j int) bool {
+		ti, _ := parseTimestamp(notes[i].CreatedAt)
+		tj, _ := parseTimestamp(notes[j].CreatedAt)
+		return ti.After(tj)
+	})
+
+	color.Cyan("📝 Notes (%d):", len(notes))
+	for _, note := range notes {
+		dimmed.Printf("  %s\n", c.relativeTimestamp(note.CreatedAt))
+		for _, line := range strings.Split(note.Content, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+	fmt.Println()
+}
+
+// addTaskNote prompts for a note and appends it to the task
+func (c *FocusForgeCLI) addTaskNote(taskID string) {
+	notePrompt := promptui.Prompt{
+		Label: "Note",
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("note cannot be empty")
+			}
+			return nil
+		},
+	}
+	note, err := notePrompt.Run()
+	if err != nil {
+		return
+	}
+
+	resp, err := c.apiClient.AddTaskNote(taskID, strings.TrimSpace(note))
+	if err != nil {
+		color.Red("❌ Failed to add note: %v", err)
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to add note: %s", errorMessage(resp))
+		return
+	}
+	color.Green("✓ Note added")
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// runOnboarding walks a first-time user through the essential settings and
+// saves them. It is shown until the config's Onboarded flag is set.
+func (c *FocusForgeCLI) runOnboarding() {
+	color.Cyan("👋 First time here? Let's get you set up.")
+	fmt.Println()
+
+	// Step 1: backend location
+	urlPrompt := promptui.Prompt{
+		Label:   "FocusForge API URL",
+		Default: c.apiURL,
+		Validate: func(input string) error {
+			u, err := url.Parse(strings.TrimSpace(input))
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("enter a full URL such as http://localhost:8000")
+			}
+			return nil
+		},
+	}
+	apiURL, err := urlPrompt.Run()
+	if err == nil {
+		c.config.APIURL = strings.TrimRight(strings.TrimSpace(apiURL), "/")
+	}
+	if !c.overridden("api_url") {
+		c.apiURL = c.config.APIURL
+	}
+
+	// Step 2: identity
+	if !c.overridden("user_id") {
+		c.getUserID()
+		c.config.UserID = c.userID
+	}
+
+	// Step 3: default category
+	categoryPrompt := promptui.Select{
+		Label: "Which category do you use most?",
+		Items: c.config.categories(),
+	}
+	if _, category, err := categoryPrompt.Run(); err == nil {
+		c.config.DefaultCategory = category
+	}
+
+	// Step 4: Pomodoro preset
+	presetItems := make([]string, len(pomodoroPresets))
+	for i, p := range pomodoroPresets {
+		presetItems[i] = fmt.Sprintf("%s (%d min focus / %d min break)", p.Name, p.FocusMinutes, p.BreakMinutes)
+	}
+	presetPrompt := promptui.Select{
+		Label: "Pick a Pomodoro preset",
+		Items: presetItems,
+	}
+	if idx, _, err := presetPrompt.Run(); err == nil {
+		c.config.PomodoroPreset = pomodoroPresets[idx].Name
+	}
+
+	c.config.Onboarded = true
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Settings saved")
+	}
+	fmt.Println()
+
+	// Step 5: optional first task
+	firstTaskPrompt := promptui.Select{
+		Label: "Create your first task now?",
+		Items: []string{"Yes", "No"},
+	}
+	if _, choice, err := firstTaskPrompt.Run(); err == nil && choice == "Yes" {
+		c.setClient(c.newAPIClient())
+		c.createNewTask()
+	}
+
+	color.Green("🎉 You're all set! Welcome to FocusForge.")
+	fmt.Println()
+}
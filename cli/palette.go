@@ -0,0 +1,143 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/manifoldco/promptui"
+)
+
+// paletteAction is an action reachable from the command palette
+type paletteAction struct {
+	Area    string
+	Label   string
+	Feature string
+	Run     func(c *FocusForgeCLI)
+}
+
+// paletteActions lists every action the command palette can jump to, grouped
+// by the menu it normally lives in
+var paletteActions = []paletteAction{
+	{"Main", "🤖 Suggest Next", featureAISuggestions, (*FocusForgeCLI).suggestNext},
+	{"Main", quickCaptureLabel, "", (*FocusForgeCLI).quickCapture},
+	{"Tasks", "➕ Create New Task", "", (*FocusForgeCLI).createNewTask},
+	{"Tasks", "📑 Create from Template", "", (*FocusForgeCLI).showTemplates},
+	{"Tasks", "📝 List My Tasks", "", (*FocusForgeCLI).listTasks},
+	{"Tasks", "🔍 View Task Details", "", (*FocusForgeCLI).viewTaskDetails},
+	{"Tasks", "✏️  Edit Task", "", (*FocusForgeCLI).editTask},
+	{"Tasks", "😴 Snooze Task", featureTaskSnooze, (*FocusForgeCLI).snoozeTask},
+	{"Tasks", "📆 Due Soon", "", (*FocusForgeCLI).showDueSoon},
+	{"Tasks", "🗃️  Process Inbox", "", (*FocusForgeCLI).processInbox},
+	{"Tasks", "↪️  Carry Over", "", (*FocusForgeCLI).carryOverTasks},
+	{"Tasks", "📆 Recurring Tasks", featureRecurrences, (*FocusForgeCLI).showRecurrences},
+	{"Tasks", "📊 Task Dashboard", "", (*FocusForgeCLI).showTaskDashboard},
+	{"Focus", "▶️  Start Focus Session", "", (*FocusForgeCLI).startFocusSession},
+	{"Focus", "▶️  Resume Task", "", (*FocusForgeCLI).resumeTask},
+	{"Focus", "⏸️  Current Session", "", (*FocusForgeCLI).showCurrentSession},
+	{"Focus", "⏹️  End Session", "", (*FocusForgeCLI).endSession},
+	{"Focus", "📊 Session History", "", (*FocusForgeCLI).showSessionHistory},
+	{"Focus", "🤝 Daily Commitment", "", (*FocusForgeCLI).showCommitment},
+	{"Mood", "⚡ Quick Mood", "", (*FocusForgeCLI).showQuickMood},
+	{"Mood", "😊 Log Mood", "", (*FocusForgeCLI).logMood},
+	{"Mood", "📊 Mood Trends", "", (*FocusForgeCLI).showMoodTrends},
+	{"Mood", "🔍 Mood Analysis", "", (*FocusForgeCLI).showMoodAnalysis},
+	{"Mood", "📥 Import Moods", "", (*FocusForgeCLI).importMoods},
+	{"Rewards", "💰 View Points & Level", featureGamification, (*FocusForgeCLI).showPointsAndLevel},
+	{"Rewards", "🏆 Achievements", featureGamification, (*FocusForgeCLI).showAchievements},
+	{"Rewards", "🛒 Store & Rewards", featureGamification, (*FocusForgeCLI).showStore},
+	{"Rewards", "📊 Progress Stats", featureGamification, (*FocusForgeCLI).showProgressStats},
+	{"Analytics", "📄 Generate Report", "", (*FocusForgeCLI).showGenerateReport},
+	{"Analytics", "🔗 Mood vs Productivity", "", (*FocusForgeCLI).showMoodProductivity},
+	{"Analytics", "📅 Activity Heatmap", "", (*FocusForgeCLI).showActivityHeatmap},
+	{"Analytics", "🗂️  Time by Category", "", (*FocusForgeCLI).showTimeByCategory},
+	{"Analytics", "🕰️  Day Timeline", "", (*FocusForgeCLI).showDayTimeline},
+	{"Analytics", "🔋 Energy vs Focus", "", (*FocusForgeCLI).showEnergyAnalysis},
+	{"Analytics", "🏅 All-Time Stats", "", (*FocusForgeCLI).showLifetimeStats},
+	{"Analytics", "🗓️  Weekly Review", "", (*FocusForgeCLI).showWeeklyReview},
+	{"Main", "🎵 Spotify Integration", featureSpotify, (*FocusForgeCLI).showSpotifyIntegration},
+	{"Settings", "🔧 API Configuration", "", (*FocusForgeCLI).showAPIConfig},
+	{"Settings", "🩺 Check All Backends", "", (*FocusForgeCLI).showBackendHealth},
+	{"Settings", "🔄 Sync Now", "", (*FocusForgeCLI).syncNow},
+	{"Settings", "👤 User Settings", "", (*FocusForgeCLI).showUserSettings},
+	{"Settings", "🗂️  Categories", "", (*FocusForgeCLI).showCategorySettings},
+	{"Settings", "🎨 Display Options", "", (*FocusForgeCLI).showDisplayOptions},
+	{"Settings", "🔕 Do Not Disturb", "", (*FocusForgeCLI).toggleDoNotDisturb},
+	{"Settings", "🔒 Strict Focus", "", (*FocusForgeCLI).toggleStrictFocus},
+	{"Settings", "🔗 Auto-Chain Blocks", "", (*FocusForgeCLI).showAutoChainSettings},
+	{"Settings", "📋 Session Checklist", "", (*FocusForgeCLI).showChecklistSettings},
+	{"Settings", "😊 Mood After Sessions", "", (*FocusForgeCLI).toggleSessionMood},
+	{"Settings", "🎉 Celebrations", "", (*FocusForgeCLI).showCelebrationSettings},
+	{"Settings", "🌴 Vacation Mode", "", (*FocusForgeCLI).showVacationSettings},
+	{"Settings", "🌧️  Focus Soundscape", "", (*FocusForgeCLI).showSoundscapeSettings},
+	{"Settings", "🔔 Bell & Sounds", "", (*FocusForgeCLI).showBellSettings},
+	{"Settings", "⏱️  Time Budget Warnings", "", (*FocusForgeCLI).showBudgetSettings},
+	{"Settings", "⌨️  Keybindings", "", (*FocusForgeCLI).showKeybindingSettings},
+	{"Settings", "💤 Idle Detection", "", (*FocusForgeCLI).showIdleSettings},
+	{"Settings", "📝 Debug Logging", "", (*FocusForgeCLI).showLoggingSettings},
+	{"Settings", "📈 Client Metrics", "", (*FocusForgeCLI).showClientMetrics},
+	{"Settings", "🔁 Retries", "", (*FocusForgeCLI).showRetrySettings},
+	{"Settings", "📤 Export Settings", "", (*FocusForgeCLI).exportSettings},
+	{"Settings", "📥 Import Settings", "", (*FocusForgeCLI).importSettings},
+	{"Settings", "🧹 Clear Local Data", "", (*FocusForgeCLI).clearLocalData},
+}
+
+// The palette is added to the quick actions here rather than in their list,
+// since it reaches the keybinding settings, which refer back to that list
+func init() {
+	quickActions = append(quickActions, quickAction{"command_palette", "🔎 Command palette", (*FocusForgeCLI).showCommandPalette})
+}
+
+// fuzzyMatch reports whether every character of query appears in text in
+// order, ignoring case and spaces, so "crtk" matches "Create New Task"
+func fuzzyMatch(query, text string) bool {
+	text = strings.ToLower(text)
+	pos := 0
+	for _, q := range strings.ToLower(query) {
+		if unicode.IsSpace(q) {
+			continue
+		}
+		i := strings.IndexRune(text[pos:], q)
+		if i < 0 {
+			return false
+		}
+		pos += i + len(string(q))
+	}
+	return true
+}
+
+// showCommandPalette lists every available action in a searchable list and
+// runs the one picked
+func (c *FocusForgeCLI) showCommandPalette() {
+	var actions []paletteAction
+	var items []string
+	for _, action := range paletteActions {
+		if action.Feature != "" && !c.featureEnabled(action.Feature) {
+			continue
+		}
+		actions = append(actions, action)
+		items = append(items, crumb(action.Area, action.Label))
+	}
+
+	prompt := promptui.Select{
+		Label:             "🔎 Type to search actions",
+		Items:             items,
+		Size:              10,
+		StartInSearchMode: true,
+		Searcher: func(input string, index int) bool {
+			return fuzzyMatch(input, menuLabel(actions[index].Label)+" "+actions[index].Area)
+		},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	action := actions[idx]
+	appLog.Debug("palette action", "action", action.Label)
+	path := "Main"
+	if action.Area != "Main" {
+		path = crumb(path, action.Area)
+	}
+	renderHeader(crumb(path, menuLabel(action.Label)))
+	action.Run(c)
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// syncLabel prefixes the main menu item that sends queued changes
+const syncLabel = "🔄 Sync Now"
+
+// Kinds of mutation the offline queue can hold
+const (
+	mutationCreateTask  = "create_task"
+	mutationLogMood     = "log_mood"
+	mutationSetVacation = "set_vacation"
+)
+
+// queuedMutation is a change made while the backend was unreachable, saved
+// so it can be sent later. IdempotencyKey is kept from the first attempt so
+// the backend can drop a request that did get through.
+type queuedMutation struct {
+	Kind           string             `json:"kind"`
+	IdempotencyKey string             `json:"idempotency_key"`
+	QueuedAt       time.Time          `json:"queued_at"`
+	Task           *TaskCreateRequest `json:"task,omitempty"`
+	Mood           *MoodLogRequest    `json:"mood,omitempty"`
+	Vacation       *bool              `json:"vacation,omitempty"`
+}
+
+// describe names the change for messages
+func (m queuedMutation) describe() string {
+	switch {
+	case m.Task != nil:
+		return fmt.Sprintf("task \"%s\"", m.Task.Title)
+	case m.Mood != nil:
+		return fmt.Sprintf("mood %s", m.Mood.Feeling)
+	case m.Vacation != nil:
+		return fmt.Sprintf("vacation mode %s", onOff(*m.Vacation))
+	}
+	return m.Kind
+}
+
+// queuePath returns the location of the offline queue file
+func queuePath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "queue.jsonl"), nil
+}
+
+// offline reports whether err means the backend couldn't be reached, so the
+// change is worth queueing rather than dropping
+func offline(err error) bool {
+	return errors.Is(err, ErrNetwork) || errors.Is(err, ErrTimeout)
+}
+
+// enqueueMutation appends m to the offline queue file
+func (c *FocusForgeCLI) enqueueMutation(m queuedMutation) error {
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
+	path, err := queuePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %v", err)
+	}
+
+	if m.QueuedAt.IsZero() {
+		m.QueuedAt = time.Now()
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode queued change: %v", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open offline queue: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write offline queue: %v", err)
+	}
+	return nil
+}
+
+// loadQueue reads the offline queue, skipping lines it can't parse
+func loadQueue() ([]queuedMutation, error) {
+	path, err := queuePath()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read offline queue: %v", err)
+	}
+	defer f.Close()
+
+	var queue []queuedMutation
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var m queuedMutation
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			appLog.Warn("skipping unreadable queued change", "error", err)
+			continue
+		}
+		queue = append(queue, m)
+	}
+	return queue, scanner.Err()
+}
+
+// saveQueue replaces the offline queue with queue, removing the file when
+// nothing is left
+func saveQueue(queue []queuedMutation) error {
+	path, err := queuePath()
+	if err != nil {
+		return err
+	}
+	if len(queue) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to clear offline queue: %v", err)
+		}
+		return nil
+	}
+
+	var b strings.Builder
+	for _, m := range queue {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return fmt.Errorf("failed to encode queued change: %v", err)
+		}
+		b.Write(data)
+		b.WriteByte('\n')
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write offline queue: %v", err)
+	}
+	return nil
+}
+
+// pendingSync returns how many changes are waiting in the offline queue
+func pendingSync() int {
+	queue, _ := loadQueue()
+	return len(queue)
+}
+
+// sendMutation replays one queued change against the backend
+func (c *FocusForgeCLI) sendMutation(m queuedMutation) error {
+	client := c.client()
+	var resp apiResponse
+	var err error
+	switch {
+	case m.Kind == mutationCreateTask && m.Task != nil:
+		req := *m.Task
+		req.IdempotencyKey = m.IdempotencyKey
+		var taskResp *TaskResponse
+		taskResp, err = client.CreateTask(req)
+		if err == nil && !taskResp.Success {
+			resp = taskResp
+		}
+	case m.Kind == mutationLogMood && m.Mood != nil:
+		req := *m.Mood
+		req.IdempotencyKey = m.IdempotencyKey
+		var moodResp *MoodResponse
+		moodResp, err = client.LogMood(req)
+		if err == nil && !moodResp.Success {
+			resp = moodResp
+		}
+	case m.Kind == mutationSetVacation && m.Vacation != nil:
+		var vacationResp *VacationResponse
+		vacationResp, err = client.SetVacationMode(*m.Vacation)
+		if err == nil && !vacationResp.Success {
+			resp = vacationResp
+		}
+	default:
+		return fmt.Errorf("unknown change %q", m.Kind)
+	}
+	if err != nil {
+		return err
+	}
+	if resp != nil {
+		return fmt.Errorf("%s", errorMessage(resp))
+	}
+	return nil
+}
+
+// flushQueue sends queued changes in order. It stops at the first one that
+// fails with a transient error - the backend unreachable, timing out or
+// failing on its side - leaving it and the rest queued; changes the backend
+// rejects are dropped. It returns how many were sent and the changes that
+// were dropped with why.
+func (c *FocusForgeCLI) flushQueue() (int, []string, error) {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+
+	c.queueMu.Lock()
+	queue, err := loadQueue()
+	c.queueMu.Unlock()
+	if err != nil || len(queue) == 0 {
+		return 0, nil, err
+	}
+
+	sent, done := 0, 0
+	var dropped []string
+	for _, m := range queue {
+		if err := c.sendMutation(m); err != nil {
+			if retryable(err) {
+				break
+			}
+			appLog.Warn("dropping queued change", "kind", m.Kind, "error", err)
+			dropped = append(dropped, fmt.Sprintf("%s: %v", m.describe(), err))
+		} else {
+			appLog.Info("synced queued change", "kind", m.Kind)
+			sent++
+		}
+		done++
+	}
+	if done == 0 {
+		return 0, nil, nil
+	}
+
+	// Changes may have been queued while sending, so reread the file and
+	// remove only the ones handled here
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+	current, err := loadQueue()
+	if err != nil {
+		return sent, dropped, err
+	}
+	if done > len(current) {
+		done = len(current)
+	}
+	return sent, dropped, saveQueue(current[done:])
+}
+
+// syncNow sends queued changes and reports how it went
+func (c *FocusForgeCLI) syncNow() {
+	color.Cyan("🔄 Sync Now")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot sync")
+		fmt.Println()
+		return
+	}
+
+	sent, dropped, err := c.flushQueue()
+	if err != nil {
+		color.Red("❌ Failed to sync: %v", err)
+	}
+	if sent > 0 {
+		color.Green("✓ Synced %d change(s)", sent)
+	}
+	for _, reason := range dropped {
+		color.Red("❌ Dropped %s", reason)
+	}
+	if left := pendingSync(); left > 0 {
+		color.Yellow("📥 %d change(s) still waiting - the backend is unreachable or failing", left)
+	}
+	fmt.Println()
+}
+
+// queueOffline saves a change that couldn't be sent and tells the user
+func (c *FocusForgeCLI) queueOffline(m queuedMutation) {
+	if err := c.enqueueMutation(m); err != nil {
+		color.Red("❌ Couldn't save it for later either: %v", err)
+		return
+	}
+	color.Yellow("📥 Saved offline - it will be sent when the backend is reachable again")
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+// quickMoodLevel maps a quick-mood key to the mood it logs
+type quickMoodLevel struct {
+	Label     string
+	Feeling   string
+	Intensity int
+}
+
+// quickMoodLevels is the 1-5 scale offered by quick mood, worst first
+var quickMoodLevels = []quickMoodLevel{
+	{Label: "😫 Very bad", Feeling: "Sad", Intensity: 8},
+	{Label: "😕 Bad", Feeling: "Stressed", Intensity: 5},
+	{Label: "😐 Okay", Feeling: "Content", Intensity: 4},
+	{Label: "🙂 Good", Feeling: "Happy", Intensity: 6},
+	{Label: "🤩 Very good", Feeling: "Excited", Intensity: 8},
+}
+
+func (c *FocusForgeCLI) showQuickMood() {
+	color.Cyan("⚡ Quick Mood")
+	fmt.Println()
+
+	level, ok := readQuickMoodLevel("cancels")
+	if !ok {
+		color.Yellow("Cancelled")
+		fmt.Println()
+		return
+	}
+
+	c.offerMoodUndo(c.logQuickMood(level))
+	fmt.Println()
+}
+
+// readQuickMoodLevel shows the quick-mood scale and reads a single 1-5
+// keypress. Any other key reports false; skipVerb says what it does.
+func readQuickMoodLevel(skipVerb string) (int, bool) {
+	for i, level := range quickMoodLevels {
+		fmt.Printf("  %d  %s\n", i+1, level.Label)
+	}
+	fmt.Println()
+	fmt.Printf("Press 1-%d to log (any other key %s): ", len(quickMoodLevels), skipVerb)
+
+	key, err := readKey()
+	fmt.Println()
+	if err != nil {
+		color.Red("Error reading key: %v", err)
+		return 0, false
+	}
+
+	level := int(key - '0')
+	if level < 1 || level > len(quickMoodLevels) {
+		return 0, false
+	}
+	return level, true
+}
+
+// logQuickMood logs the mood for a 1-5 quick-mood level with its default
+// intensity and no note. It returns the logged entry, or nil on failure.
+func (c *FocusForgeCLI) logQuickMood(level int) *MoodLog {
+	if level < 1 || level > len(quickMoodLevels) {
+		return nil
+	}
+	mood := quickMoodLevels[level-1]
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - mood not logged")
+		return nil
+	}
+
+	resp, err := c.apiClient.LogMood(MoodLogRequest{
+		Feeling:   mood.Feeling,
+		Intensity: mood.Intensity,
+	})
+	if err != nil {
+		color.Red("❌ Failed to log mood: %v", err)
+		return nil
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to log mood: %s", errorMessage(resp))
+		return nil
+	}
+
+	c.rememberAction(fmt.Sprintf("Quick mood %s", mood.Label), false, func() { c.logQuickMood(level) })
+	color.Green("✓ Logged %s", mood.Label)
+	return resp.MoodLog
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// maxRating is the top of the 1-5 star scale completed tasks are rated on
+const maxRating = 5
+
+// stars draws a rating as filled and empty stars
+func stars(rating int) string {
+	if rating < 0 {
+		rating = 0
+	}
+	if rating > maxRating {
+		rating = maxRating
+	}
+	return strings.Repeat("★", rating) + strings.Repeat("☆", maxRating-rating)
+}
+
+// promptRating asks how a task went with a single 1-5 keypress. Enter or
+// any other key skips, returning 0.
+func promptRating() int {
+	fmt.Printf("How did it go? Press 1-%d to rate (Enter to skip): ", maxRating)
+	key, err := readKey()
+	fmt.Println()
+	if err != nil {
+		return 0
+	}
+
+	rating := int(key - '0')
+	if rating < 1 || rating > maxRating {
+		return 0
+	}
+	return rating
+}
+
+// completeTask marks task complete after asking for an optional rating,
+// updating task in place
+func (c *FocusForgeCLI) completeTask(task *Task) {
+	rating := promptRating()
+
+	resp, err := c.apiClient.CompleteTask(task.ID, rating)
+	if err != nil {
+		color.Red("❌ Failed to mark task complete: %v", err)
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to mark task complete: %s", errorMessage(resp))
+		return
+	}
+
+	task.Status = "completed"
+	task.Rating = rating
+	message := fmt.Sprintf("Task complete: %s", task.Title)
+	if rating > 0 {
+		message += " " + stars(rating)
+	}
+	c.celebrate(withTokens(message, resp.TokensEarned))
+	c.celebrateAchievements(resp.NewAchievements)
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// joinWords joins items as "a", "a and b" or "a, b and c"
+func joinWords(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+	}
+}
+
+// ordinal renders n as "1st", "2nd", "3rd", "11th" and so on
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// describeCadence renders how often a recurrence runs, such as "every
+// weekday" or "weekly on Monday"
+func describeCadence(r *Recurrence) string {
+	days := make([]string, len(r.Weekdays))
+	for i, day := range r.Weekdays {
+		if day != "" {
+			days[i] = strings.ToUpper(day[:1]) + strings.ToLower(day[1:])
+		}
+	}
+
+	switch r.Frequency {
+	case "daily":
+		if r.Interval > 1 {
+			return fmt.Sprintf("every %d days", r.Interval)
+		}
+		return "every day"
+	case "weekdays":
+		return "every weekday"
+	case "weekly":
+		cadence := "weekly"
+		if r.Interval > 1 {
+			cadence = fmt.Sprintf("every %d weeks", r.Interval)
+		}
+		if len(days) > 0 {
+			cadence += " on " + joinWords(days)
+		}
+		return cadence
+	case "monthly":
+		cadence := "monthly"
+		if r.Interval > 1 {
+			cadence = fmt.Sprintf("every %d months", r.Interval)
+		}
+		if r.DayOfMonth > 0 {
+			cadence += " on the " + ordinal(r.DayOfMonth)
+		}
+		return cadence
+	default:
+		return r.Frequency
+	}
+}
+
+func (c *FocusForgeCLI) showRecurrences() {
+	for {
+		color.Cyan("📆 Recurring Tasks")
+		fmt.Println()
+
+		if c.apiClient == nil {
+			color.Yellow("⚠️  API client not available - cannot load recurring tasks")
+			fmt.Println()
+			return
+		}
+
+		resp, err := c.apiClient.GetRecurrences()
+		if err != nil {
+			color.Red("❌ Failed to load recurring tasks: %v", err)
+			fmt.Println()
+			return
+		}
+		if !resp.Success {
+			color.Red("❌ Failed to load recurring tasks: %s", errorMessage(resp))
+			fmt.Println()
+			return
+		}
+		if len(resp.Recurrences) == 0 {
+			color.Yellow("No recurring tasks")
+			fmt.Println()
+			return
+		}
+
+		items := make([]string, 0, len(resp.Recurrences)+1)
+		for _, r := range resp.Recurrences {
+			next := "next: " + c.formatTimestamp(r.NextRun)
+			if r.Paused {
+				next = "⏸️  paused"
+			} else if r.NextRun == "" {
+				next = "next: unknown"
+			}
+			items = append(items, fmt.Sprintf("%s - %s (%s)", r.Title, describeCadence(r), next))
+		}
+		items = append(items, "🔙 Back")
+
+		prompt := promptui.Select{
+			Label: "Select a recurring task to manage",
+			Items: items,
+			Size:  10,
+		}
+		idx, _, err := prompt.Run()
+		if err != nil || idx == len(items)-1 {
+			return
+		}
+
+		c.manageRecurrence(resp.Recurrences[idx])
+		fmt.Println()
+	}
+}
+
+// manageRecurrence pauses, resumes or deletes a recurrence
+func (c *FocusForgeCLI) manageRecurrence(r *Recurrence) {
+	toggle := "⏸️  Pause"
+	if r.Paused {
+		toggle = "▶️  Resume"
+	}
+
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("\"%s\" runs %s", r.Title, describeCadence(r)),
+		Items: []string{toggle, "🗑️  Delete", "🔙 Back"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	switch choice {
+	case "⏸️  Pause", "▶️  Resume":
+		resp, err := c.apiClient.PauseRecurrence(r.ID, !r.Paused)
+		if err != nil {
+			color.Red("❌ Failed to update recurring task: %v", err)
+			return
+		}
+		if !resp.Success {
+			color.Red("❌ Failed to update recurring task: %s", errorMessage(resp))
+			return
+		}
+		appLog.Info("recurrence updated", "recurrence", r.ID, "paused", !r.Paused)
+		if r.Paused {
+			color.Green("▶️  \"%s\" will create tasks again", r.Title)
+		} else {
+			color.Yellow("⏸️  \"%s\" paused - no new tasks until you resume it", r.Title)
+		}
+	case "🗑️  Delete":
+		confirm := promptui.Select{
+			Label: fmt.Sprintf("Delete \"%s\"? Tasks it already created are kept", r.Title),
+			Items: []string{"No", "Yes"},
+		}
+		if _, answer, err := confirm.Run(); err != nil || answer != "Yes" {
+			return
+		}
+		resp, err := c.apiClient.DeleteRecurrence(r.ID)
+		if err != nil {
+			color.Red("❌ Failed to delete recurring task: %v", err)
+			return
+		}
+		if !resp.Success {
+			color.Red("❌ Failed to delete recurring task: %s", errorMessage(resp))
+			return
+		}
+		appLog.Info("recurrence deleted", "recurrence", r.ID)
+		color.Green("✓ Recurring task \"%s\" deleted", r.Title)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// repeatLabel prefixes the main menu item that replays the last action
+const repeatLabel = "🔁 Repeat Last"
+
+// repeatableAction is a completed action that can be replayed with the same
+// inputs from the main menu
+type repeatableAction struct {
+	Description string
+	Destructive bool
+	Run         func()
+}
+
+// rememberAction records the action just completed so it can be repeated
+func (c *FocusForgeCLI) rememberAction(description string, destructive bool, run func()) {
+	c.lastAction = &repeatableAction{
+		Description: description,
+		Destructive: destructive,
+		Run:         run,
+	}
+}
+
+// repeatMenuItem returns the main menu entry for the last action, or "" if
+// nothing can be repeated yet
+func (c *FocusForgeCLI) repeatMenuItem() string {
+	if c.lastAction == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", repeatLabel, c.lastAction.Description)
+}
+
+func (c *FocusForgeCLI) repeatLast() {
+	action := c.lastAction
+	if action == nil {
+		return
+	}
+
+	color.Cyan("🔁 %s", action.Description)
+	fmt.Println()
+
+	if action.Destructive {
+		confirmPrompt := promptui.Select{
+			Label: "This can't be undone. Repeat it?",
+			Items: []string{"No", "Yes"},
+		}
+		_, choice, err := confirmPrompt.Run()
+		if err != nil || choice != "Yes" {
+			return
+		}
+	}
+
+	action.Run()
+}
+
+// replayMood logs a mood with the same inputs as an earlier entry
+func (c *FocusForgeCLI) replayMood(moodReq MoodLogRequest) {
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - mood not logged")
+		fmt.Println()
+		return
+	}
+
+	// A repeat is a new entry, so it must not reuse the original's key
+	moodReq.IdempotencyKey = newIdempotencyKey()
+	resp, err := c.apiClient.LogMood(moodReq)
+	if err != nil {
+		color.Red("❌ Failed to log mood: %v", err)
+	} else if !resp.Success {
+		color.Red("❌ Failed to log mood: %s", errorMessage(resp))
+	} else {
+		color.Green("✓ Logged %s (%s) again", moodReq.Feeling, c.formatIntensity(moodReq.Intensity))
+	}
+	fmt.Println()
+}
+
+// replayTask creates a task like an earlier one, letting the user adjust
+// the title first
+func (c *FocusForgeCLI) replayTask(taskReq TaskCreateRequest) {
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - task not created")
+		fmt.Println()
+		return
+	}
+
+	titlePrompt := promptui.Prompt{
+		Label:   "Task Title",
+		Default: taskReq.Title,
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("title cannot be empty")
+			}
+			return nil
+		},
+	}
+	title, err := titlePrompt.Run()
+	if err != nil {
+		return
+	}
+	taskReq.Title = strings.TrimSpace(title)
+	taskReq.IdempotencyKey = newIdempotencyKey()
+	if !c.reviewTask(&taskReq) {
+		return
+	}
+
+	resp, err := c.apiClient.CreateTask(taskReq)
+	if err != nil {
+		color.Red("❌ Failed to create task: %v", err)
+	} else if !resp.Success {
+		color.Red("❌ Failed to create task: %s", errorMessage(resp))
+	} else {
+		color.Green("✓ Task created: %s (%s, %s, %d min)", taskReq.Title, taskReq.Category, taskReq.Priority, taskReq.DurationMinutes)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// reportPeriods maps the report periods offered to the user to how far back
+// they reach
+var reportPeriods = map[string]time.Duration{
+	"weekly":  7 * 24 * time.Hour,
+	"monthly": 30 * 24 * time.Hour,
+}
+
+func (c *FocusForgeCLI) showGenerateReport() {
+	color.Cyan("📄 Generate Report")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot generate a report")
+		fmt.Println()
+		return
+	}
+
+	periodPrompt := promptui.Select{
+		Label: "Report period",
+		Items: []string{"weekly", "monthly"},
+	}
+	_, period, err := periodPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	pathPrompt := promptui.Prompt{
+		Label:   "Output file",
+		Default: fmt.Sprintf("report-%s.md", time.Now().Format("2006-01")),
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("output file cannot be empty")
+			}
+			return nil
+		},
+	}
+	path, err := pathPrompt.Run()
+	if err != nil {
+		return
+	}
+	path = strings.TrimSpace(path)
+
+	if _, err := os.Stat(path); err == nil {
+		overwritePrompt := promptui.Select{
+			Label: fmt.Sprintf("%s already exists. Overwrite it?", path),
+			Items: []string{"No", "Yes"},
+		}
+		_, choice, err := overwritePrompt.Run()
+		if err != nil || choice != "Yes" {
+			color.Yellow("Report not written.")
+			fmt.Println()
+			return
+		}
+	}
+
+	color.Yellow("Generating %s report...", period)
+	if err := c.generateReport(period, path); err != nil {
+		color.Red("❌ Failed to generate report: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+
+	color.Green("✓ Report written to %s", path)
+	fmt.Println()
+
+	copyPrompt := promptui.Select{
+		Label: "Copy the report path?",
+		Items: []string{"No", copyLabel},
+	}
+	if _, choice, err := copyPrompt.Run(); err == nil && choice == copyLabel {
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		copyToClipboard("the report path", path)
+	}
+}
+
+// generateReport pulls analytics, completed tasks and mood logs for period
+// ("weekly" or "monthly") and writes them to path as Markdown
+func (c *FocusForgeCLI) generateReport(period string, path string) error {
+	span, ok := reportPeriods[period]
+	if !ok {
+		return fmt.Errorf("unknown report period %q", period)
+	}
+	to := time.Now()
+	from := to.Add(-span)
+
+	analytics, err := c.apiClient.GetAnalytics()
+	if err != nil {
+		return fmt.Errorf("failed to fetch analytics: %v", err)
+	}
+
+	taskResp, err := c.apiClient.GetTasks("completed", "", 200)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks: %v", err)
+	}
+
+	moodResp, err := c.apiClient.GetMoodLogs(200)
+	if err != nil {
+		return fmt.Errorf("failed to fetch mood logs: %v", err)
+	}
+
+	var tasks []*Task
+	for _, task := range taskResp.Tasks {
+		if inPeriod(task.UpdatedAt, from, to) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	type moodSummary struct {
+		count          int
+		totalIntensity int
+	}
+	moods := map[string]*moodSummary{}
+	for _, entry := range moodResp.MoodLogs {
+		if !inPeriod(entry.Timestamp, from, to) {
+			continue
+		}
+		summary, ok := moods[entry.Feeling]
+		if !ok {
+			summary = &moodSummary{}
+			moods[entry.Feeling] = summary
+		}
+		summary.count++
+		summary.totalIntensity += entry.Intensity
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# FocusForge %s Report\n\n", strings.ToUpper(period[:1])+period[1:])
+	fmt.Fprintf(&b, "_%s – %s_\n\n", from.Format("Jan 2, 2006"), to.Format("Jan 2, 2006"))
+
+	b.WriteString("## Summary\n\n")
+	b.WriteString("| Metric | Value |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Total focus sessions | %d |\n", analytics.TotalSessions)
+	fmt.Fprintf(&b, "| Current streak | %d days |\n", analytics.CurrentStreak)
+	fmt.Fprintf(&b, "| Best streak | %d days |\n", analytics.BestStreak)
+	fmt.Fprintf(&b, "| Tasks completed | %d |\n", len(tasks))
+	if stats := taskResp.Stats; stats != nil {
+		fmt.Fprintf(&b, "| Completion rate | %.1f%% |\n", stats.CompletionRate)
+		fmt.Fprintf(&b, "| Tokens earned | %d |\n", stats.TotalTokens)
+	}
+	b.WriteString("\n")
+
+	b.WriteString("## Completed Tasks\n\n")
+	if len(tasks) == 0 {
+		b.WriteString("No tasks completed in this period.\n\n")
+	} else {
+		b.WriteString("| Task | Category | Priority | Duration |\n|---|---|---|---|\n")
+		for _, task := range tasks {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+				markdownEscape(task.Title), task.Category, task.Priority, c.formatMinutes(task.DurationMinutes))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Mood Summary\n\n")
+	if len(moods) == 0 {
+		b.WriteString("No moods logged in this period.\n")
+	} else {
+		feelings := make([]string, 0, len(moods))
+		for feeling := range moods {
+			feelings = append(feelings, feeling)
+		}
+		sort.Slice(feelings, func(i, j int) bool {
+			return moods[feelings[i]].count > moods[feelings[j]].count
+		})
+
+		b.WriteString("| Feeling | Times Logged | Avg Intensity |\n|---|---|---|\n")
+		for _, feeling := range feelings {
+			summary := moods[feeling]
+			fmt.Fprintf(&b, "| %s | %d | %.1f |\n", feeling, summary.count,
+				float64(summary.totalIntensity)/float64(summary.count))
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+
+	return nil
+}
+
+// inPeriod reports whether timestamp falls within [from, to]. Entries with
+// unparseable timestamps are included rather than silently dropped.
+func inPeriod(timestamp string, from, to time.Time) bool {
+	t, ok := parseTimestamp(timestamp)
+	if !ok {
+		return true
+	}
+	return !t.Before(from) && !t.After(to)
+}
+
+// markdownEscape keeps user text from breaking table cells
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
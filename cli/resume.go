@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// blockPosition returns the 1-based position of block within task's blocks
+func blockPosition(task *Task, block *TaskBlock) int {
+	for i, b := range task.Blocks {
+		if b.ID == block.ID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// resumeTask picks up a broken-down task where it was left: it finds the
+// first block not yet checked off and starts a session on it for that
+// block's duration. When every block is done it offers to complete the task.
+func (c *FocusForgeCLI) resumeTask() {
+	color.Cyan("▶️  Resume Task")
+	fmt.Println()
+
+	if active := c.session(); active != nil {
+		color.Yellow("⚠️  You already have an active session on: %s", active.TaskTitle)
+		fmt.Println()
+		return
+	}
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot start a session")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+
+	// Offer broken-down tasks first; if the list leaves blocks out, offer
+	// every open task and check its blocks once it is loaded
+	var open, withBlocks []*Task
+	for _, task := range resp.Tasks {
+		if task.Status == "completed" {
+			continue
+		}
+		open = append(open, task)
+		if len(task.Blocks) > 0 {
+			withBlocks = append(withBlocks, task)
+		}
+	}
+	if len(withBlocks) > 0 {
+		open = withBlocks
+	}
+	if len(open) == 0 {
+		color.Yellow("No open tasks to resume.")
+		fmt.Println()
+		return
+	}
+
+	selected := selectTask("Which task will you pick up?", open)
+	if selected == nil {
+		return
+	}
+
+	taskResp, err := c.apiClient.GetTask(selected.ID)
+	if err != nil {
+		color.Red("❌ Failed to load task: %v", err)
+		fmt.Println()
+		return
+	}
+	if !taskResp.Success || taskResp.Task == nil {
+		color.Red("❌ Failed to load task: %s", errorMessage(taskResp))
+		fmt.Println()
+		return
+	}
+	task := taskResp.Task
+
+	if len(task.Blocks) == 0 {
+		color.Yellow("\"%s\" has no blocks - use \"▶️  Start Focus Session\" to work on it.", task.Title)
+		fmt.Println()
+		return
+	}
+
+	block := nextBlock(task)
+	if block == nil {
+		color.Green("✓ All %d blocks of \"%s\" are done", len(task.Blocks), task.Title)
+		if task.Status != "completed" && confirmContinue("Mark the task complete") {
+			c.completeTask(task)
+		}
+		fmt.Println()
+		return
+	}
+
+	duration := block.DurationMinutes
+	if duration <= 0 {
+		duration = c.config.preset().FocusMinutes
+	}
+	color.Cyan("Block %d of %d: %s (%d min)", blockPosition(task, block), len(task.Blocks), block.Title, duration)
+	fmt.Println()
+
+	if !c.prerequisitesDone(task) || !c.checkTimeBudget(task, duration) || !c.runChecklist() || !c.runStartBuffer() {
+		color.Yellow("Session not started")
+		fmt.Println()
+		return
+	}
+
+	color.Yellow("Starting session...")
+
+	session, err := c.launchSession(task, duration, block.ID, "", "")
+	if err != nil {
+		color.Red("❌ Failed to start session: %v", err)
+		fmt.Println()
+		waitForEnter()
+		return
+	}
+	appLog.Info("task resumed", "task", task.ID, "block", block.ID)
+
+	color.Green("✓ Focus session started on: %s - %s", task.Title, block.Title)
+	fmt.Printf("  Duration: %d minutes\n", duration)
+	fmt.Printf("  Ends at: %s\n", c.formatTime(session.StartedAt.Add(time.Duration(duration)*time.Minute), "15:04"))
+	fmt.Println()
+	waitForEnter()
+}
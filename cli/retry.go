@@ -0,0 +1,189 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// Defaults and bounds for automatic retries, with delays in milliseconds
+const (
+	defaultRetryAttempts  = 2
+	defaultRetryBaseDelay = 500
+	defaultRetryMaxDelay  = 5000
+
+	maxRetryAttempts = 10
+	minRetryDelay    = 100
+	maxRetryDelay    = 60000
+)
+
+// retryPolicy controls how the API client retries a failed request before
+// giving up. The zero value never retries.
+type retryPolicy struct {
+	// Attempts is how many times a request is retried after the first try
+	Attempts int
+	// BaseDelay is the wait before the first retry, doubled for each one
+	// after it up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// delay returns how long to wait before the given retry, counting from 1
+func (p retryPolicy) delay(retry int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < retry && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// retryPolicy returns the configured retry policy, falling back to the
+// defaults for values out of range
+func (cfg *Config) retryPolicy() retryPolicy {
+	attempts := cfg.RetryAttempts
+	if attempts < 0 || attempts > maxRetryAttempts {
+		attempts = defaultRetryAttempts
+	}
+	base := cfg.RetryBaseDelay
+	if base < minRetryDelay || base > maxRetryDelay {
+		base = defaultRetryBaseDelay
+	}
+	max := cfg.RetryMaxDelay
+	if max < base || max > maxRetryDelay {
+		max = defaultRetryMaxDelay
+		if max < base {
+			max = base
+		}
+	}
+	return retryPolicy{
+		Attempts:  attempts,
+		BaseDelay: time.Duration(base) * time.Millisecond,
+		MaxDelay:  time.Duration(max) * time.Millisecond,
+	}
+}
+
+// SetRetryPolicy changes how failed requests are retried automatically
+func (c *APIClient) SetRetryPolicy(p retryPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.retry = p
+}
+
+// safeToRetry reports whether sending req again can't do anything twice:
+// reads and idempotent writes, or any request carrying an idempotency key.
+// Its body must also be replayable.
+func safeToRetry(req *http.Request) bool {
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get(idempotencyHeader) != ""
+}
+
+// retryableStatus reports whether a response status is worth retrying
+func retryableStatus(code int) bool {
+	return code >= 500
+}
+
+// retryable reports whether a failed request is worth retrying as is:
+// network trouble, timeouts and server errors, but not bad input
+func retryable(err error) bool {
+	return errors.Is(err, ErrNetwork) || errors.Is(err, ErrTimeout) || errors.Is(err, ErrServer)
+}
+
+// withRetryPrompt runs fn, and while it fails with a retryable error asks the
+// user whether to try again. fn should resend the same request so nothing
+// the user entered is lost. It returns fn's last error.
+func withRetryPrompt(fn func() error) error {
+	for {
+		err := fn()
+		if err == nil || !retryable(err) {
+			return err
+		}
+
+		color.Red("Request failed: %v", err)
+		fmt.Print("[R]etry / [C]ancel: ")
+		key, keyErr := readKey()
+		fmt.Println()
+		if keyErr != nil || strings.ToLower(string(rune(key))) != "r" {
+			return err
+		}
+		appLog.Info("retrying request", "error", err)
+		color.Yellow("Retrying...")
+	}
+}
+
+func (c *FocusForgeCLI) showRetrySettings() {
+	for {
+		color.Cyan("🔁 Retries")
+		fmt.Println()
+
+		fmt.Println("Requests that fail with a network or server error are retried")
+		fmt.Println("automatically, waiting longer before each retry. Only requests that")
+		fmt.Println("are safe to repeat are retried, so nothing is ever created twice.")
+		fmt.Println()
+		dimmed.Printf("Defaults: %d retries, %d ms base delay, %d ms max delay\n", defaultRetryAttempts, defaultRetryBaseDelay, defaultRetryMaxDelay)
+		fmt.Println()
+
+		policy := c.config.retryPolicy()
+		attemptsItem := fmt.Sprintf("🔢 Retries: %d", policy.Attempts)
+		baseItem := fmt.Sprintf("⏱️  Base delay: %d ms", policy.BaseDelay.Milliseconds())
+		maxItem := fmt.Sprintf("⏳ Max delay: %d ms", policy.MaxDelay.Milliseconds())
+		menuItems := []string{attemptsItem, baseItem, maxItem, "♻️  Reset to Defaults", "🔙 Back"}
+
+		prompt := promptui.Select{
+			Label: "Select an option to change",
+			Items: menuItems,
+		}
+		_, choice, err := prompt.Run()
+		if err != nil || choice == "🔙 Back" {
+			return
+		}
+
+		switch choice {
+		case attemptsItem:
+			value, ok := promptInt("Times to retry a failed request (0 to fail fast)", policy.Attempts, 0, maxRetryAttempts)
+			if !ok {
+				continue
+			}
+			c.config.RetryAttempts = value
+		case baseItem:
+			value, ok := promptInt("Milliseconds to wait before the first retry", int(policy.BaseDelay.Milliseconds()), minRetryDelay, int(policy.MaxDelay.Milliseconds()))
+			if !ok {
+				continue
+			}
+			c.config.RetryBaseDelay = value
+		case maxItem:
+			value, ok := promptInt("Longest wait between retries, in milliseconds", int(policy.MaxDelay.Milliseconds()), int(policy.BaseDelay.Milliseconds()), maxRetryDelay)
+			if !ok {
+				continue
+			}
+			c.config.RetryMaxDelay = value
+		case "♻️  Reset to Defaults":
+			c.config.RetryAttempts = defaultRetryAttempts
+			c.config.RetryBaseDelay = defaultRetryBaseDelay
+			c.config.RetryMaxDelay = defaultRetryMaxDelay
+		}
+
+		if c.apiClient != nil {
+			c.apiClient.SetRetryPolicy(c.config.retryPolicy())
+		}
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Settings saved")
+		}
+		fmt.Println()
+	}
+}
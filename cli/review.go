@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// printTaskReview shows a task that is about to be created, followed by any
+// extra "Label: value" notes about how it will be created
+func printTaskReview(req *TaskCreateRequest, notes []string) {
+	color.Cyan("Review your task:")
+	fmt.Printf("  Title: %s\n", req.Title)
+	if req.Description != "" {
+		fmt.Printf("  Description: %s\n", req.Description)
+	}
+	fmt.Printf("  Duration: %d minutes\n", req.DurationMinutes)
+	fmt.Printf("  Category: %s\n", req.Category)
+	fmt.Printf("  Priority: %s\n", req.Priority)
+	if req.EffortPoints > 0 {
+		fmt.Printf("  Effort: %d pts\n", req.EffortPoints)
+	}
+	if req.ColorLabel != "" {
+		fmt.Printf("  Color Label: %s\n", req.ColorLabel)
+	}
+	if len(req.DependsOn) > 0 {
+		fmt.Printf("  Depends On: %d task(s)\n", len(req.DependsOn))
+	}
+	for _, note := range notes {
+		fmt.Printf("  %s\n", note)
+	}
+	fmt.Println()
+}
+
+// reviewTask shows req and asks whether to create it, letting the user
+// change single fields first. It reports whether to go ahead.
+func (c *FocusForgeCLI) reviewTask(req *TaskCreateRequest, notes ...string) bool {
+	for {
+		printTaskReview(req, notes)
+		fmt.Print("Create this task? [Y/n/e]dit: ")
+		key, err := readKey()
+		fmt.Println()
+		if err != nil {
+			return false
+		}
+
+		switch strings.ToLower(string(rune(key))) {
+		case "y", "\r", "\n":
+			return true
+		case "e":
+			c.pickTaskField(req)
+		default:
+			color.Yellow("Task not created")
+			fmt.Println()
+			return false
+		}
+	}
+}
+
+// pickTaskField lets the user choose one field of req and change it
+func (c *FocusForgeCLI) pickTaskField(req *TaskCreateRequest) {
+	items := []string{
+		fmt.Sprintf("Title: %s", req.Title),
+		fmt.Sprintf("Description: %s", req.Description),
+		fmt.Sprintf("Duration: %d minutes", req.DurationMinutes),
+		fmt.Sprintf("Category: %s", req.Category),
+		fmt.Sprintf("Priority: %s", req.Priority),
+		fmt.Sprintf("Effort: %d pts", req.EffortPoints),
+		fmt.Sprintf("Color Label: %s", req.ColorLabel),
+		"🔙 Back",
+	}
+	prompt := promptui.Select{
+		Label: "Which field would you like to change?",
+		Items: items,
+		Size:  10,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil || idx >= len(taskFields) {
+		return
+	}
+	if err := c.editTaskField(req, taskFields[idx]); err != nil {
+		return
+	}
+	fmt.Println()
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// secretMask stands in for the hidden part of a secret
+const secretMask = "••••••••"
+
+// maskSecret hides all but the last 4 characters of a secret, such as
+// "••••••••cd12". Secrets too short to spare 4 characters are hidden
+// entirely, and an empty secret stays empty.
+func maskSecret(s string) string {
+	if s == "" {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) <= 8 {
+		return secretMask
+	}
+	return secretMask + string(runes[len(runes)-4:])
+}
+
+// secretLogKeys are log attribute keys whose values are always masked
+var secretLogKeys = []string{"token", "authorization", "password", "secret"}
+
+// redactSecrets masks log attributes that could hold credentials, so a
+// secret never reaches the log file whatever the log level
+func redactSecrets(groups []string, a slog.Attr) slog.Attr {
+	key := strings.ToLower(a.Key)
+	for _, secret := range secretLogKeys {
+		if strings.Contains(key, secret) {
+			return slog.String(a.Key, maskSecret(a.Value.String()))
+		}
+	}
+	return a
+}
@@ -0,0 +1,516 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// focusSession tracks the focus session the user is currently working in.
+// The timing fields are shared with the timer goroutine and guarded by mu.
+type focusSession struct {
+	ID              string
+	TaskID          string
+	TaskTitle       string
+	DurationMinutes int
+	StartedAt       time.Time
+
+	// BlockID is the task block the session works through, if it was
+	// chained from the task's breakdown
+	BlockID string
+
+	// Goal is what the user set out to get done in the session, and
+	// GoalAchieved their answer when it ended
+	Goal         string
+	GoalAchieved *bool
+
+	mu        sync.Mutex
+	pausedAt  time.Time
+	pausedFor time.Duration
+	pauses    int
+	// distractions is how many times the user noted getting distracted
+	distractions int
+	done         chan struct{}
+	stopOnce     sync.Once
+
+	// ambient is the soundscape playing during the session, if any
+	ambient *soundscape
+
+	// heartbeatErr is the last progress sync failure, cleared on success
+	heartbeatErr error
+
+	// Idle detection: lastActivity is the last interaction with the session,
+	// checkInAt when the user was asked if they are still there, and
+	// idlePaused whether the timer was paused because they didn't answer
+	lastActivity time.Time
+	checkInAt    time.Time
+	idlePaused   bool
+}
+
+// heartbeatInterval is how often a running session reports its progress
+const heartbeatInterval = 30 * time.Second
+
+// newFocusSession creates the local state for a session started on the backend
+func newFocusSession(id string, task *Task, durationMinutes int) *focusSession {
+	return &focusSession{
+		ID:              id,
+		TaskID:          task.ID,
+		TaskTitle:       task.Title,
+		DurationMinutes: durationMinutes,
+		StartedAt:       time.Now(),
+		done:            make(chan struct{}),
+		lastActivity:    time.Now(),
+	}
+}
+
+// sessionState is the on-disk record of the active session, used to recover
+// it if the CLI exits without ending the session
+type sessionState struct {
+	ID              string        `json:"id"`
+	TaskID          string        `json:"task_id"`
+	TaskTitle       string        `json:"task_title"`
+	DurationMinutes int           `json:"duration_minutes"`
+	StartedAt       time.Time     `json:"started_at"`
+	BlockID         string        `json:"block_id,omitempty"`
+	Goal            string        `json:"goal,omitempty"`
+	PausedAt        time.Time     `json:"paused_at,omitempty"`
+	PausedFor       time.Duration `json:"paused_for"`
+	Pauses          int           `json:"pauses"`
+	Distractions    int           `json:"distractions,omitempty"`
+	// LastSeen is when the state was last saved by a running CLI, so time
+	// after it can be left out if the CLI died mid-session
+	LastSeen time.Time `json:"last_seen,omitempty"`
+}
+
+// sessionStatePath returns the location of the session state file
+func sessionStatePath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "session.json"), nil
+}
+
+// saveSessionState records the session so it survives a crash
+func saveSessionState(state sessionState) error {
+	path, err := sessionStatePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create state directory: %v", err)
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode session state: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write session state: %v", err)
+	}
+	return nil
+}
+
+// loadSessionState returns the saved session, or nil if none was left behind
+func loadSessionState() (*sessionState, error) {
+	path, err := sessionStatePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session state: %v", err)
+	}
+
+	var state sessionState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse session state: %v", err)
+	}
+	return &state, nil
+}
+
+// clearSessionState removes the saved session after it ends cleanly
+func clearSessionState() error {
+	path, err := sessionStatePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove session state: %v", err)
+	}
+	return nil
+}
+
+// restoreFocusSession rebuilds a session from its saved state. A session that
+// was running is restored paused from when it was last seen, so the time the
+// CLI was not running is not counted as focus.
+func restoreFocusSession(state *sessionState) *focusSession {
+	pausedAt := state.PausedAt
+	if pausedAt.IsZero() && !state.LastSeen.IsZero() {
+		pausedAt = state.LastSeen
+	}
+	return &focusSession{
+		ID:              state.ID,
+		TaskID:          state.TaskID,
+		TaskTitle:       state.TaskTitle,
+		DurationMinutes: state.DurationMinutes,
+		StartedAt:       state.StartedAt,
+		BlockID:         state.BlockID,
+		Goal:            state.Goal,
+		pausedAt:        pausedAt,
+		pausedFor:       state.PausedFor,
+		pauses:          state.Pauses,
+		distractions:    state.Distractions,
+		done:            make(chan struct{}),
+		lastActivity:    time.Now(),
+	}
+}
+
+// state snapshots the session for saving to disk
+func (s *focusSession) state() sessionState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sessionState{
+		ID:              s.ID,
+		TaskID:          s.TaskID,
+		TaskTitle:       s.TaskTitle,
+		DurationMinutes: s.DurationMinutes,
+		StartedAt:       s.StartedAt,
+		BlockID:         s.BlockID,
+		Goal:            s.Goal,
+		PausedAt:        s.pausedAt,
+		PausedFor:       s.pausedFor,
+		Pauses:          s.pauses,
+		Distractions:    s.distractions,
+		LastSeen:        time.Now(),
+	}
+}
+
+// persistSession saves the session's current state, warning on failure since
+// losing crash recovery should not interrupt the session itself
+func (c *FocusForgeCLI) persistSession(s *focusSession) {
+	if err := saveSessionState(s.state()); err != nil {
+		color.Yellow("⚠️  Could not save session state: %v", err)
+	}
+}
+
+// recoverSession looks for a session left behind by a previous run and lets
+// the user resume its timer or end it
+func (c *FocusForgeCLI) recoverSession() {
+	state, err := loadSessionState()
+	if err != nil {
+		color.Yellow("⚠️  %v", err)
+		return
+	}
+	if state == nil {
+		return
+	}
+
+	session := restoreFocusSession(state)
+	color.Yellow("⚠️  Found an unfinished focus session on \"%s\" started at %s (%d min focused).",
+		session.TaskTitle, c.formatTime(session.StartedAt, "Jan 2 15:04"), int(session.elapsed().Minutes()))
+
+	prompt := promptui.Select{
+		Label: "What would you like to do with it?",
+		Items: []string{"▶️  Resume the timer", "⏹️  End it now", "🗑️  Discard it"},
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	switch idx {
+	case 0:
+		// Restart the clock unless the user had paused it themselves
+		if state.PausedAt.IsZero() {
+			session.resume()
+		}
+		c.setSession(session)
+		c.persistSession(session)
+		c.startAmbient(session)
+		c.goBackground(func() { c.runTimer(session) })
+		color.Green("✓ Session resumed")
+		fmt.Println()
+	case 1:
+		c.setSession(session)
+		c.finishSession(false)
+	case 2:
+		// Abort it on the backend too, or it stays open there
+		if _, err := c.closeSession(session, true); err != nil {
+			color.Red("❌ Failed to abort session: %v", err)
+			if !confirmContinue("Discard it on this device anyway") {
+				color.Yellow("The session will be offered again next time")
+				fmt.Println()
+				return
+			}
+			if err := clearSessionState(); err != nil {
+				color.Yellow("⚠️  %v", err)
+			}
+		}
+		color.Yellow("Session discarded")
+		fmt.Println()
+	}
+}
+
+// planned returns the planned length of the session
+func (s *focusSession) planned() time.Duration {
+	return time.Duration(s.DurationMinutes) * time.Minute
+}
+
+// elapsed returns the focused time so far, excluding pauses
+func (s *focusSession) elapsed() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paused := s.pausedFor
+	if !s.pausedAt.IsZero() {
+		paused += time.Since(s.pausedAt)
+	}
+	return time.Since(s.StartedAt) - paused
+}
+
+// isPaused reports whether the timer is currently paused
+func (s *focusSession) isPaused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.pausedAt.IsZero()
+}
+
+// pause stops the clock, returning false if it was already paused
+func (s *focusSession) pause() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.pausedAt.IsZero() {
+		return false
+	}
+	s.pausedAt = time.Now()
+	s.pauses++
+	return true
+}
+
+// resume restarts the clock, returning false if it was not paused
+func (s *focusSession) resume() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pausedAt.IsZero() {
+		return false
+	}
+	s.pausedFor += time.Since(s.pausedAt)
+	s.pausedAt = time.Time{}
+	return true
+}
+
+// setHeartbeatErr records the outcome of the latest progress sync
+func (s *focusSession) setHeartbeatErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.heartbeatErr = err
+}
+
+// lastHeartbeatErr returns the latest progress sync failure, if any
+func (s *focusSession) lastHeartbeatErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.heartbeatErr
+}
+
+// pauseCount returns how many times the session has been paused
+func (s *focusSession) pauseCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pauses
+}
+
+// addDistraction counts one more distraction and returns the new total
+func (s *focusSession) addDistraction() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.distractions++
+	return s.distractions
+}
+
+// distractionCount returns how many distractions were noted in the session
+func (s *focusSession) distractionCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.distractions
+}
+
+// stop ends the timer goroutine and any soundscape. It is safe to call more
+// than once.
+func (s *focusSession) stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+		if s.ambient != nil {
+			s.ambient.Stop()
+		}
+	})
+}
+
+// runTimer watches the session in the background, notifying the user once
+// the planned focus time has been reached and sending a progress heartbeat to
+// the backend every heartbeatInterval. It returns when the session stops.
+func (c *FocusForgeCLI) runTimer(s *focusSession) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	notified := false
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-c.ctx.Done():
+			return
+		case <-heartbeat.C:
+			c.sendHeartbeat(s)
+		case <-ticker.C:
+			if c.config.IdleDetection {
+				c.checkIdle(s)
+			}
+			if !notified && s.elapsed() >= s.planned() {
+				notified = true
+				c.ringBell()
+				fmt.Println()
+				color.Green("⏰ Focus time is up for \"%s\"! End the session from 🎯 Focus Sessions.", s.TaskTitle)
+				if s.Goal != "" {
+					color.Cyan("🎯 Goal: %s", s.Goal)
+				}
+			}
+		}
+	}
+}
+
+// sendHeartbeat reports the session's progress. Failures are recorded on the
+// session for display but never interrupt the timer.
+func (c *FocusForgeCLI) sendHeartbeat(s *focusSession) {
+	if s.isPaused() {
+		return
+	}
+
+	resp, err := c.client().UpdateSessionProgress(s.ID, int(s.elapsed().Seconds()))
+	if err == nil && !resp.Success {
+		err = fmt.Errorf("%s", errorMessage(resp))
+	}
+	s.setHeartbeatErr(err)
+
+	// Refresh the saved state so a crash loses at most one interval
+	if err := saveSessionState(s.state()); err != nil {
+		appLog.Warn("couldn't save session state", "session", s.ID, "error", err)
+	}
+}
+
+// startAmbient begins the configured soundscape for a session. Playback
+// problems are reported but never stop the session.
+func (c *FocusForgeCLI) startAmbient(s *focusSession) {
+	if c.config.Soundscape == "" {
+		return
+	}
+
+	ambient, err := startSoundscape(c.config.Soundscape, c.config.SoundVolume)
+	if err != nil {
+		color.Yellow("⚠️  Could not play soundscape: %v", err)
+		return
+	}
+	s.ambient = ambient
+	color.Green("🌧️  Playing %s", c.config.Soundscape)
+}
+
+// checkTimeBudget warns when a session of duration minutes would push the
+// task's total focused time past its estimate by more than the configured
+// threshold. It reports whether the session should go ahead.
+func (c *FocusForgeCLI) checkTimeBudget(task *Task, duration int) bool {
+	if !c.config.BudgetWarnings || task.ID == "" {
+		return true
+	}
+
+	// Without the estimate or the actuals there is nothing to compare, so
+	// never block the session on a failed lookup
+	taskResp, err := c.apiClient.GetTask(task.ID)
+	if err != nil || !taskResp.Success || taskResp.Task == nil || taskResp.Task.DurationMinutes <= 0 {
+		return true
+	}
+	sessionResp, err := c.apiClient.GetTaskSessions(task.ID)
+	if err != nil || !sessionResp.Success {
+		return true
+	}
+
+	estimate := taskResp.Task.DurationMinutes
+	actual := 0
+	for _, session := range sessionResp.Sessions {
+		actual += session.ActualMinutes
+	}
+
+	over := actual + duration - estimate
+	if over <= estimate*c.config.BudgetThreshold/100 {
+		return true
+	}
+
+	color.Yellow("⚠️  You've focused %d of an estimated %d minutes on this task.", actual, estimate)
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("This will exceed your estimate by %d minutes - continue?", over),
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	return err == nil && choice == "Yes"
+}
+
+// Focus score weighting. A session earns up to 70 points for the share of
+// planned time actually focused, 20 points for finishing without aborting, and
+// 10 points for staying uninterrupted, losing pausePenalty points per pause.
+const (
+	completionWeight = 70
+	noAbortWeight    = 20
+	noPauseWeight    = 10
+	pausePenalty     = 3
+)
+
+// focusScore rates a session from 0 to 100 using the weighting above
+func focusScore(session Session) int {
+	if session.DurationMinutes <= 0 {
+		return 0
+	}
+
+	ratio := float64(session.ActualMinutes) / float64(session.DurationMinutes)
+	if ratio > 1 {
+		ratio = 1
+	}
+	score := int(ratio * completionWeight)
+
+	if !session.Aborted {
+		score += noAbortWeight
+	}
+
+	pauseScore := noPauseWeight - session.Pauses*pausePenalty
+	if pauseScore > 0 {
+		score += pauseScore
+	}
+
+	return score
+}
+
+// scoreColor returns the color used to render a focus score
+func scoreColor(score int) *color.Color {
+	switch {
+	case score >= 80:
+		return color.New(color.FgGreen)
+	case score >= 50:
+		return color.New(color.FgYellow)
+	default:
+		return color.New(color.FgRed)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+// How the length of a new session is chosen
+const (
+	sessionLengthPrompt = "prompt" // ask, suggesting the task's duration
+	sessionLengthTask   = "task"   // the task's full duration
+	sessionLengthPreset = "preset" // one Pomodoro of the current preset
+)
+
+// sessionLengths lists the session length options offered in settings
+var sessionLengths = []string{sessionLengthPrompt, sessionLengthTask, sessionLengthPreset}
+
+// sessionMinutes picks the length of a new session on task according to the
+// session length setting, prompting if it says to. A task duration outside
+// the allowed range falls back to the preset. It reports false if the prompt
+// was cancelled.
+func (c *FocusForgeCLI) sessionMinutes(task *Task) (int, bool) {
+	preset := c.config.preset().FocusMinutes
+	taskMinutes := task.DurationMinutes
+	if !durationInRange(taskMinutes) {
+		taskMinutes = preset
+	}
+
+	switch c.config.SessionLength {
+	case sessionLengthPreset:
+		return preset, true
+	case sessionLengthTask:
+		return taskMinutes, true
+	}
+
+	defaultMinutes := taskMinutes
+	durationPrompt := promptui.Prompt{
+		Label:    "Session length in minutes",
+		Default:  strconv.Itoa(defaultMinutes),
+		Validate: validateDuration,
+	}
+	durationStr, err := durationPrompt.Run()
+	if err != nil {
+		return 0, false
+	}
+	duration, _ := strconv.Atoi(strings.TrimSpace(durationStr))
+	return duration, true
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// promptSessionMood offers the quick-mood scale at the end of a session.
+// Any key other than 1-5 skips it.
+func (c *FocusForgeCLI) promptSessionMood() {
+	color.Cyan("😊 How are you feeling after that session?")
+	level, ok := readQuickMoodLevel("skips")
+	if !ok {
+		dimmed.Println("Mood skipped")
+		return
+	}
+	c.offerMoodUndo(c.logQuickMood(level))
+}
+
+func (c *FocusForgeCLI) toggleSessionMood() {
+	color.Cyan("😊 Mood After Sessions")
+	fmt.Println()
+
+	fmt.Println("When this is on, every focus session ends with the quick-mood scale so")
+	fmt.Println("you can log how it went with a single key. Any other key skips it.")
+	fmt.Println()
+	fmt.Printf("Mood after sessions: %s\n", onOff(c.config.MoodAfterSession))
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label: "Ask for your mood after each session?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	c.config.MoodAfterSession = choice == "Yes"
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+		fmt.Println()
+		return
+	}
+
+	color.Green("✓ Settings saved")
+	fmt.Println()
+}
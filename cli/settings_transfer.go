@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// defaultSettingsExportFile is offered as the export/import path
+const defaultSettingsExportFile = "focusforge-settings.json"
+
+// expandHome resolves a leading ~ in a user-entered path
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, path[1:])
+		}
+	}
+	return path
+}
+
+func (c *FocusForgeCLI) exportSettings() {
+	color.Cyan("📤 Export Settings")
+	fmt.Println()
+
+	pathPrompt := promptui.Prompt{
+		Label:   "Export to",
+		Default: defaultSettingsExportFile,
+	}
+	path, err := pathPrompt.Run()
+	if err != nil {
+		return
+	}
+	path = expandHome(strings.TrimSpace(path))
+
+	secretsPrompt := promptui.Select{
+		Label: "Include your API token and user ID? Only do this for files you won't share",
+		Items: []string{"No, redact it", "Yes, include it"},
+	}
+	_, choice, err := secretsPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	exported := *c.config
+	// The user ID goes with the token, so a shared file carries neither
+	if choice != "Yes, include it" {
+		exported.Token = ""
+		exported.UserID = ""
+	}
+
+	data, err := json.MarshalIndent(&exported, "", "  ")
+	if err != nil {
+		color.Red("❌ Failed to export settings: %v", err)
+		fmt.Println()
+		return
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		color.Red("❌ Failed to export settings: %v", err)
+		fmt.Println()
+		return
+	}
+
+	color.Green("✓ Settings exported to %s", path)
+	fmt.Println()
+}
+
+func (c *FocusForgeCLI) importSettings() {
+	color.Cyan("📥 Import Settings")
+	fmt.Println()
+
+	pathPrompt := promptui.Prompt{
+		Label:   "Import from",
+		Default: defaultSettingsExportFile,
+	}
+	path, err := pathPrompt.Run()
+	if err != nil {
+		return
+	}
+	path = expandHome(strings.TrimSpace(path))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		color.Red("❌ Failed to read %s: %v", path, err)
+		fmt.Println()
+		return
+	}
+
+	modePrompt := promptui.Select{
+		Label: "How should the imported settings be applied?",
+		Items: []string{
+			"Merge - only change the settings in the file",
+			"Replace - reset everything else to defaults",
+			"Cancel",
+		},
+	}
+	idx, _, err := modePrompt.Run()
+	if err != nil || idx == 2 {
+		return
+	}
+
+	// Unmarshalling over a copy only touches the fields present in the file
+	imported := defaultConfig()
+	if idx == 0 {
+		imported = c.config.clone()
+	}
+	if err := json.Unmarshal(data, imported); err != nil {
+		color.Red("❌ Failed to parse %s: %v", path, err)
+		fmt.Println()
+		return
+	}
+	if err := validateKeybindings(imported.keybindings()); err != nil {
+		color.Red("❌ Imported keybindings are invalid: %v", err)
+		fmt.Println()
+		return
+	}
+
+	// A shared file normally has the token and user ID redacted; keep the
+	// user's own, as a pair so the token always matches the account
+	if imported.Token == "" {
+		imported.Token = c.config.Token
+		imported.UserID = c.config.UserID
+	}
+
+	c.config = imported
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+		fmt.Println()
+		return
+	}
+	c.reapplySettings()
+
+	color.Green("✓ Settings imported from %s", path)
+	fmt.Println()
+}
+
+// reapplySettings brings the running CLI in line with c.config after it was
+// replaced. Settings overridden by a flag or environment variable keep their
+// override.
+func (c *FocusForgeCLI) reapplySettings() {
+	if !c.overridden("api_url") {
+		c.apiURL, c.sources["api_url"] = resolveSetting("", "", c.config.APIURL, defaultConfig().APIURL)
+	}
+	if !c.overridden("user_id") {
+		c.userID, c.sources["user_id"] = resolveSetting("", "", c.config.UserID, "")
+	}
+	if !c.overridden("token") {
+		c.token, c.sources["token"] = resolveSetting("", "", c.config.Token, "")
+	}
+	c.applyTimezone()
+	c.applyTheme()
+
+	client := c.newAPIClient()
+	c.setClient(client)
+	c.setConnected(client.HealthCheck() == nil)
+	if c.isConnected() {
+		c.loadFeatures()
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// shutdownTimeout bounds how long shutdown waits for the active session to
+// be ended and background goroutines to stop
+const shutdownTimeout = 5 * time.Second
+
+// goBackground runs fn in a goroutine that shutdown waits for. fn should
+// return once c.ctx is cancelled.
+func (c *FocusForgeCLI) goBackground(fn func()) {
+	c.background.Add(1)
+	go func() {
+		defer c.background.Done()
+		fn()
+	}()
+}
+
+// signalExitCode returns the conventional exit status for a process ended by
+// sig: 128 plus the signal number, so 130 for SIGINT and 143 for SIGTERM
+func signalExitCode(sig os.Signal) int {
+	if s, ok := sig.(syscall.Signal); ok {
+		return 128 + int(s)
+	}
+	return 128 + int(syscall.SIGINT)
+}
+
+// handleSignals shuts the CLI down cleanly on SIGINT or SIGTERM
+func (c *FocusForgeCLI) handleSignals() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		sig := <-signals
+		fmt.Println()
+		color.Yellow("Shutting down...")
+		c.shutdown()
+		os.Exit(signalExitCode(sig))
+	}()
+}
+
+// shutdown ends any active session, saves the config and stops background
+// goroutines, giving up after shutdownTimeout. It runs at most once; later
+// calls wait for the first to finish.
+func (c *FocusForgeCLI) shutdown() {
+	c.shutdownOnce.Do(func() {
+		c.stopRunning()
+		c.cancel()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			if session := c.session(); session != nil {
+				if _, err := c.closeSession(session, false); err != nil {
+					// The saved state lets the next run recover the session
+					session.stop()
+					c.persistSession(session)
+					color.Yellow("⚠️  Could not end the session (%v) - it will be offered for recovery next time", err)
+				}
+			}
+
+			if !c.configBroken {
+				if err := saveConfig(c.config); err != nil {
+					color.Red("❌ Failed to save settings: %v", err)
+				}
+			}
+
+			c.background.Wait()
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(shutdownTimeout):
+			color.Yellow("⚠️  Shutdown timed out - some work may not have been saved")
+		}
+		appLog.Info("shut down")
+		closeLogging()
+	})
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// snoozeInputLayout is the format accepted for a custom snooze time
+const snoozeInputLayout = "2006-01-02 15:04"
+
+// snoozedUntil reports when a snoozed task wakes up, and false if the task is
+// not currently snoozed
+func snoozedUntil(task *Task) (time.Time, bool) {
+	until, ok := parseTimestamp(task.SnoozedUntil)
+	if !ok || task.Status == "completed" || !until.After(time.Now()) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// snoozePresets returns the preset wake times offered when snoozing, in the
+// user's display timezone
+func (c *FocusForgeCLI) snoozePresets() ([]string, []time.Time) {
+	loc := c.location
+	if loc == nil {
+		loc = time.Local
+	}
+	now := time.Now().In(loc)
+	morning := func(days int) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day()+days, 9, 0, 0, 0, loc)
+	}
+	daysToMonday := (8 - int(now.Weekday())) % 7
+	if daysToMonday == 0 {
+		daysToMonday = 7
+	}
+
+	return []string{"In 1 hour", "Tomorrow morning", "Next week"},
+		[]time.Time{now.Add(time.Hour), morning(1), morning(daysToMonday)}
+}
+
+func (c *FocusForgeCLI) snoozeTask() {
+	color.Cyan("😴 Snooze Task")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot snooze tasks")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+
+	var tasks []*Task
+	for _, task := range resp.Tasks {
+		if task.Status != "completed" {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) == 0 {
+		color.Yellow("No open tasks to snooze.")
+		fmt.Println()
+		return
+	}
+
+	task := selectTask("Which task should be snoozed?", tasks)
+	if task == nil {
+		return
+	}
+
+	labels, times := c.snoozePresets()
+	items := make([]string, len(labels), len(labels)+1)
+	for i, label := range labels {
+		items[i] = fmt.Sprintf("%s (%s)", label, c.formatTime(times[i], displayTimeLayout))
+	}
+	items = append(items, "Custom time...")
+
+	untilPrompt := promptui.Select{
+		Label: "Snooze until",
+		Items: items,
+	}
+	idx, _, err := untilPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	var until time.Time
+	if idx < len(times) {
+		until = times[idx]
+	} else {
+		loc := c.location
+		if loc == nil {
+			loc = time.Local
+		}
+		customPrompt := promptui.Prompt{
+			Label: "Wake time (YYYY-MM-DD HH:MM)",
+			Validate: func(input string) error {
+				t, err := time.ParseInLocation(snoozeInputLayout, strings.TrimSpace(input), loc)
+				if err != nil {
+					return fmt.Errorf("use the format YYYY-MM-DD HH:MM")
+				}
+				if !t.After(time.Now()) {
+					return fmt.Errorf("wake time must be in the future")
+				}
+				return nil
+			},
+		}
+		input, err := customPrompt.Run()
+		if err != nil {
+			return
+		}
+		until, _ = time.ParseInLocation(snoozeInputLayout, strings.TrimSpace(input), loc)
+	}
+
+	snoozeResp, err := c.apiClient.SnoozeTask(task.ID, until)
+	if err != nil {
+		color.Red("❌ Failed to snooze task: %v", err)
+	} else if !snoozeResp.Success {
+		color.Red("❌ Failed to snooze task: %s", errorMessage(snoozeResp))
+	} else {
+		color.Green("💤 \"%s\" snoozed until %s", task.Title, c.formatTime(until, displayTimeLayout))
+	}
+	fmt.Println()
+}
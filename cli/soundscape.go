@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// soundExtensions lists the audio formats looked up in the sounds directory
+var soundExtensions = []string{".wav", ".mp3", ".ogg", ".aiff"}
+
+// errNoAudioPlayer is returned when no supported audio player is installed
+var errNoAudioPlayer = errors.New("no supported audio player found (install ffplay, or use afplay on macOS / paplay on Linux)")
+
+// soundsDir returns the directory holding ambient sound files
+func soundsDir() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "sounds"), nil
+}
+
+// availableSoundscapes lists the sound names found in the sounds directory
+func availableSoundscapes() []string {
+	dir, err := soundsDir()
+	if err != nil {
+		return nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		for _, known := range soundExtensions {
+			if strings.EqualFold(ext, known) {
+				names = append(names, strings.TrimSuffix(entry.Name(), ext))
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// soundscapePath finds the audio file for a soundscape name
+func soundscapePath(name string) (string, error) {
+	dir, err := soundsDir()
+	if err != nil {
+		return "", err
+	}
+	for _, ext := range soundExtensions {
+		path := filepath.Join(dir, name+ext)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no sound file for %q in %s", name, dir)
+}
+
+// playerCommand builds the command that plays path once at volume (0-100)
+// using whichever player is installed
+func playerCommand(path string, volume int) (*exec.Cmd, error) {
+	if _, err := exec.LookPath("ffplay"); err == nil {
+		return exec.Command("ffplay", "-nodisp", "-autoexit", "-loglevel", "quiet",
+			"-volume", fmt.Sprint(volume), path), nil
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		if _, err := exec.LookPath("afplay"); err == nil {
+			return exec.Command("afplay", "-v", fmt.Sprintf("%.2f", float64(volume)/100), path), nil
+		}
+	case "linux":
+		if _, err := exec.LookPath("paplay"); err == nil {
+			return exec.Command("paplay", fmt.Sprintf("--volume=%d", volume*65536/100), path), nil
+		}
+	case "windows":
+		// Media.SoundPlayer has no volume control; quotes in the path are
+		// doubled to stay inside the single-quoted PowerShell string
+		script := fmt.Sprintf("(New-Object Media.SoundPlayer '%s').PlaySync()", strings.ReplaceAll(path, "'", "''"))
+		return exec.Command("powershell", "-NoProfile", "-Command", script), nil
+	}
+
+	return nil, errNoAudioPlayer
+}
+
+// volumeSupported reports whether the player playerCommand picks honours
+// the volume setting. Only the PowerShell fallback on Windows ignores it.
+func volumeSupported() bool {
+	if _, err := exec.LookPath("ffplay"); err == nil {
+		return true
+	}
+	return runtime.GOOS != "windows"
+}
+
+// soundscape loops an ambient sound in the background until stopped
+type soundscape struct {
+	path   string
+	volume int
+
+	mu      sync.Mutex
+	cmd     *exec.Cmd
+	stopped bool
+	done    chan struct{}
+}
+
+// startSoundscape starts looping the named sound. It fails up front if the
+// sound file or an audio player is missing.
+func startSoundscape(name string, volume int) (*soundscape, error) {
+	path, err := soundscapePath(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := playerCommand(path, volume); err != nil {
+		return nil, err
+	}
+
+	s := &soundscape{path: path, volume: volume, done: make(chan struct{})}
+	go s.loop()
+	return s, nil
+}
+
+// loop replays the sound each time the player exits until Stop is called
+func (s *soundscape) loop() {
+	defer close(s.done)
+
+	for {
+		s.mu.Lock()
+		if s.stopped {
+			s.mu.Unlock()
+			return
+		}
+		cmd, err := playerCommand(s.path, s.volume)
+		if err != nil || cmd.Start() != nil {
+			s.mu.Unlock()
+			return
+		}
+		s.cmd = cmd
+		s.mu.Unlock()
+
+		if err := cmd.Wait(); err != nil {
+			// A player that fails immediately would otherwise spin forever
+			s.mu.Lock()
+			stopped := s.stopped
+			s.mu.Unlock()
+			if !stopped {
+				return
+			}
+		}
+	}
+}
+
+// Stop ends playback and waits for the player to exit
+func (s *soundscape) Stop() {
+	s.mu.Lock()
+	s.stopped = true
+	if s.cmd != nil && s.cmd.Process != nil {
+		s.cmd.Process.Kill()
+	}
+	s.mu.Unlock()
+	<-s.done
+}
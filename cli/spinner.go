@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// startSpinner shows an animated spinner next to msg until the returned stop
+// function is called. Stop clears the spinner line and is safe to call twice.
+func startSpinner(msg string) func() {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+
+		start := time.Now()
+		for i := 0; ; i++ {
+			elapsed := int(time.Since(start).Seconds())
+			fmt.Printf("\r%s %s (%ds)", spinnerFrames[i%len(spinnerFrames)], msg, elapsed)
+
+			select {
+			case <-done:
+				// Overwrite the spinner line so following output starts clean
+				fmt.Printf("\r%s\r", strings.Repeat(" ", len(msg)+16))
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			wg.Wait()
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+// taskStatuses lists the statuses a task moves through, in order
+var taskStatuses = []string{"pending", "in_progress", "completed"}
+
+// selectStatuses lets the user tick any number of statuses, starting from
+// current. Selecting none means no filter.
+func selectStatuses(current []string) []string {
+	const done = "✓ Done"
+
+	selected := make(map[string]bool)
+	for _, status := range current {
+		selected[status] = true
+	}
+	for {
+		items := make([]string, 0, len(taskStatuses)+1)
+		items = append(items, done)
+		for _, status := range taskStatuses {
+			mark := "[ ]"
+			if selected[status] {
+				mark = "[x]"
+			}
+			items = append(items, fmt.Sprintf("%s %s", mark, status))
+		}
+
+		prompt := promptui.Select{
+			Label: "Show tasks with these statuses (none for all)",
+			Items: items,
+		}
+		idx, _, err := prompt.Run()
+		if err != nil || idx == 0 {
+			break
+		}
+
+		status := taskStatuses[idx-1]
+		selected[status] = !selected[status]
+	}
+
+	var statuses []string
+	for _, status := range taskStatuses {
+		if selected[status] {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// statusFilterLabel describes a status filter for list headers
+func statusFilterLabel(statuses []string) string {
+	if len(statuses) == 0 {
+		return "all statuses"
+	}
+	return strings.Join(statuses, " or ")
+}
+
+// filterByStatus keeps the tasks whose status is one of statuses, or all of
+// them when statuses is empty. It backs up the backend filter, which older
+// backends apply to a single status only or not at all.
+func filterByStatus(tasks []*Task, statuses []string) []*Task {
+	if len(statuses) == 0 {
+		return tasks
+	}
+	var kept []*Task
+	for _, task := range tasks {
+		for _, status := range statuses {
+			if task.Status == status {
+				kept = append(kept, task)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// promptStatusFilter asks whether to change the task list's status filter,
+// returning false if the user backed out
+func (c *FocusForgeCLI) promptStatusFilter() bool {
+	items := []string{
+		fmt.Sprintf("Keep showing %s", statusFilterLabel(c.statusFilter)),
+		"Change status filter",
+	}
+	prompt := promptui.Select{
+		Label: "Status filter",
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return false
+	}
+	if idx == 1 {
+		c.statusFilter = selectStatuses(c.statusFilter)
+	}
+	return true
+}
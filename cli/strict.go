@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// focusLocked reports whether strict focus is holding the user on the
+// session screen
+func (c *FocusForgeCLI) focusLocked() bool {
+	return c.config.StrictFocus && c.session() != nil
+}
+
+func (c *FocusForgeCLI) toggleStrictFocus() {
+	color.Cyan("🔒 Strict Focus")
+	fmt.Println()
+
+	fmt.Println("While a session is running, strict focus hides the menus and only shows")
+	fmt.Println("the session screen. Everything unlocks when the session ends or is aborted.")
+	fmt.Println()
+	fmt.Printf("Strict focus: %s\n", onOff(c.config.StrictFocus))
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label: "Lock the menus during focus sessions?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	c.config.StrictFocus = choice == "Yes"
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+		fmt.Println()
+		return
+	}
+
+	color.Green("✓ Settings saved")
+	fmt.Println()
+}
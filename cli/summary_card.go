@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+// summaryCardWidth is the minimum inside width of the end-of-session card
+const summaryCardWidth = 40
+
+// printSummaryCard shows a session's outcome as a boxed card. Details the
+// backend didn't send, like tokens or the streak, are left out.
+func (c *FocusForgeCLI) printSummaryCard(session *focusSession, outcome Session) {
+	title, border := "✓ Session complete!", color.New(color.FgGreen)
+	if outcome.Aborted {
+		title, border = "🛑 Session aborted", color.New(color.FgYellow)
+	}
+
+	lines := []string{
+		title,
+		"",
+		fmt.Sprintf("Task:        %s", session.TaskTitle),
+		fmt.Sprintf("Focused:     %s of %s", c.formatMinutes(outcome.ActualMinutes), c.formatMinutes(outcome.DurationMinutes)),
+		fmt.Sprintf("Pauses:      %d", outcome.Pauses),
+		fmt.Sprintf("Distracted:  %d", outcome.Distractions),
+		fmt.Sprintf("Focus score: %d/100 %s", outcome.FocusScore, scoreBar(outcome.FocusScore)),
+	}
+	if outcome.TokensEarned > 0 {
+		lines = append(lines, fmt.Sprintf("Tokens:      +%d 🪙", outcome.TokensEarned))
+	}
+	if streak := c.currentStreak(); streak > 0 {
+		lines = append(lines, fmt.Sprintf("Streak:      %d days 🔥", streak))
+	}
+	if session.GoalAchieved != nil {
+		mark := "✗"
+		if *session.GoalAchieved {
+			mark = "✓"
+		}
+		lines = append(lines, fmt.Sprintf("Goal:        %s %s", mark, session.Goal))
+	}
+
+	printBox(border, lines, roundedBox, summaryCardWidth, false)
+}
+
+// scoreBar draws a focus score as a ten-segment bar
+func scoreBar(score int) string {
+	filled := score / 10
+	if filled < 0 {
+		filled = 0
+	}
+	if filled > 10 {
+		filled = 10
+	}
+	bar := ""
+	for i := 0; i < 10; i++ {
+		if i < filled {
+			bar += "█"
+		} else {
+			bar += "░"
+		}
+	}
+	return bar
+}
+
+// currentStreak returns the user's current streak in days, or 0 if it
+// couldn't be fetched
+func (c *FocusForgeCLI) currentStreak() int {
+	if c.apiClient == nil {
+		return 0
+	}
+	analytics, err := c.apiClient.GetAnalytics()
+	if err != nil {
+		appLog.Debug("streak unavailable for summary card", "error", err)
+		return 0
+	}
+	return analytics.CurrentStreak
+}
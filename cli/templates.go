@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// newTemplateLabel is the template picker entry that saves an existing task
+// as a template
+const newTemplateLabel = "💾 Save a Task as Template"
+
+// SaveTemplate stores req under name, replacing any template with that name.
+// Prerequisites are dropped since they point at specific tasks.
+func (cfg *Config) SaveTemplate(name string, req TaskCreateRequest) {
+	if cfg.Templates == nil {
+		cfg.Templates = make(map[string]TaskCreateRequest)
+	}
+	req.DependsOn = nil
+	req.IdempotencyKey = ""
+	cfg.Templates[name] = req
+}
+
+// templateNames returns the saved template names in alphabetical order
+func (cfg *Config) templateNames() []string {
+	names := make([]string, 0, len(cfg.Templates))
+	for name := range cfg.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *FocusForgeCLI) showTemplates() {
+	for {
+		color.Cyan("📑 Create from Template")
+		fmt.Println()
+
+		names := c.config.templateNames()
+		if len(names) == 0 {
+			color.Yellow("No templates yet. Save one of your tasks as a template to reuse it.")
+			fmt.Println()
+		}
+
+		items := make([]string, 0, len(names)+2)
+		for _, name := range names {
+			tmpl := c.config.Templates[name]
+			items = append(items, fmt.Sprintf("%s (%s, %s, %d min)", name, tmpl.Category, tmpl.Priority, tmpl.DurationMinutes))
+		}
+		items = append(items, newTemplateLabel, "🔙 Back")
+
+		prompt := promptui.Select{
+			Label: "Pick a template",
+			Items: items,
+			Size:  10,
+		}
+		idx, choice, err := prompt.Run()
+		if err != nil {
+			return
+		}
+
+		switch {
+		case choice == newTemplateLabel:
+			c.saveTaskAsTemplate()
+		case idx < len(names):
+			c.manageTemplate(names[idx])
+		default:
+			return
+		}
+	}
+}
+
+// manageTemplate offers to create a task from a template or delete it
+func (c *FocusForgeCLI) manageTemplate(name string) {
+	prompt := promptui.Select{
+		Label: name,
+		Items: []string{"➕ Create Task", "🗑️  Delete Template", "🔙 Back"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	switch choice {
+	case "➕ Create Task":
+		c.createFromTemplate(c.config.Templates[name])
+	case "🗑️  Delete Template":
+		confirmPrompt := promptui.Select{
+			Label: fmt.Sprintf("Delete template \"%s\"?", name),
+			Items: []string{"No", "Yes"},
+		}
+		if _, confirm, err := confirmPrompt.Run(); err != nil || confirm != "Yes" {
+			return
+		}
+		delete(c.config.Templates, name)
+		if err := saveConfig(c.config); err != nil {
+			color.Red("❌ Failed to save settings: %v", err)
+		} else {
+			color.Green("✓ Template \"%s\" deleted", name)
+		}
+		fmt.Println()
+	}
+}
+
+// createFromTemplate creates a task from tmpl, letting the user adjust the
+// title, duration and priority first
+func (c *FocusForgeCLI) createFromTemplate(tmpl TaskCreateRequest) {
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - task not created")
+		fmt.Println()
+		return
+	}
+
+	titlePrompt := promptui.Prompt{
+		Label:   "Task Title",
+		Default: tmpl.Title,
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("title cannot be empty")
+			}
+			return nil
+		},
+	}
+	title, err := titlePrompt.Run()
+	if err != nil {
+		return
+	}
+
+	durationPrompt := promptui.Prompt{
+		Label:    "Duration in minutes",
+		Default:  strconv.Itoa(tmpl.DurationMinutes),
+		Validate: validateDuration,
+	}
+	durationStr, err := durationPrompt.Run()
+	if err != nil {
+		return
+	}
+	duration, _ := strconv.Atoi(strings.TrimSpace(durationStr))
+
+	priorityPrompt := promptui.Select{
+		Label:     "Task Priority",
+		Items:     taskPriorities,
+		CursorPos: indexOf(taskPriorities, tmpl.Priority),
+	}
+	_, priority, err := priorityPrompt.Run()
+	if err != nil {
+		return
+	}
+
+	taskReq := tmpl
+	taskReq.Title = strings.TrimSpace(title)
+	taskReq.DurationMinutes = duration
+	taskReq.Priority = priority
+	taskReq.IdempotencyKey = newIdempotencyKey()
+	if !c.reviewTask(&taskReq) {
+		return
+	}
+
+	var resp *TaskResponse
+	err = withRetryPrompt(func() error {
+		var err error
+		resp, err = c.apiClient.CreateTask(taskReq)
+		return err
+	})
+	if err != nil {
+		color.Red("❌ Failed to create task: %v", err)
+	} else if !resp.Success {
+		color.Red("❌ Failed to create task: %s", errorMessage(resp))
+	} else {
+		c.rememberAction(fmt.Sprintf("Create a task like \"%s\"", taskReq.Title), false, func() { c.replayTask(taskReq) })
+		color.Green("✓ Task created: %s (%s, %s, %d min)", taskReq.Title, taskReq.Category, taskReq.Priority, taskReq.DurationMinutes)
+	}
+	fmt.Println()
+}
+
+// saveTaskAsTemplate saves one of the user's tasks as a named template
+func (c *FocusForgeCLI) saveTaskAsTemplate() {
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load tasks")
+		fmt.Println()
+		return
+	}
+
+	resp, err := c.apiClient.GetTasks("", "", c.config.listLimit())
+	if err != nil {
+		color.Red("❌ Failed to fetch tasks: %v", err)
+		fmt.Println()
+		return
+	}
+	if !resp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(resp))
+		fmt.Println()
+		return
+	}
+	if len(resp.Tasks) == 0 {
+		color.Yellow("No tasks found. Create your first task!")
+		fmt.Println()
+		return
+	}
+
+	task := selectTask("Which task should the template copy?", resp.Tasks)
+	if task == nil {
+		return
+	}
+
+	namePrompt := promptui.Prompt{
+		Label:   "Template name",
+		Default: task.Title,
+		Validate: func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return fmt.Errorf("name cannot be empty")
+			}
+			return nil
+		},
+	}
+	name, err := namePrompt.Run()
+	if err != nil {
+		return
+	}
+	name = strings.TrimSpace(name)
+
+	if _, exists := c.config.Templates[name]; exists {
+		overwritePrompt := promptui.Select{
+			Label: fmt.Sprintf("A template named \"%s\" already exists. Replace it?", name),
+			Items: []string{"No", "Yes"},
+		}
+		if _, choice, err := overwritePrompt.Run(); err != nil || choice != "Yes" {
+			return
+		}
+	}
+
+	c.config.SaveTemplate(name, TaskCreateRequest{
+		Title:           task.Title,
+		Description:     task.Description,
+		DurationMinutes: task.DurationMinutes,
+		Category:        task.Category,
+		Priority:        task.Priority,
+		ColorLabel:      task.ColorLabel,
+		EffortPoints:    task.EffortPoints,
+	})
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	} else {
+		color.Green("✓ Template \"%s\" saved", name)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/chzyer/readline"
+	"github.com/manifoldco/promptui"
+	"github.com/mattn/go-isatty"
+	"golang.org/x/term"
+)
+
+// stdoutIsTerminal reports whether output goes to an interactive terminal
+func stdoutIsTerminal() bool {
+	fd := os.Stdout.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// defaultTerminalWidth is assumed when the width can't be measured, e.g.
+// when output is piped
+const defaultTerminalWidth = 80
+
+// narrowTerminal is the width below which views switch to a compact,
+// single-column layout
+const narrowTerminal = 60
+
+// terminalWidth returns the current width of the terminal in columns. It is
+// measured on every call so views follow the terminal as it is resized.
+func terminalWidth() int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		return defaultTerminalWidth
+	}
+	return width
+}
+
+// isNarrow reports whether the terminal is too narrow for multi-column views
+func isNarrow() bool {
+	return terminalWidth() < narrowTerminal
+}
+
+// clearScreen clears the terminal. It does nothing when output is piped so
+// escape codes never end up in files or other programs' input.
+func clearScreen() {
+	if !stdoutIsTerminal() {
+		return
+	}
+
+	if runtime.GOOS == "windows" {
+		cmd := exec.Command("cmd", "/c", "cls")
+		cmd.Stdout = os.Stdout
+		if cmd.Run() == nil {
+			return
+		}
+	}
+
+	// Clear the screen and move the cursor to the top-left corner
+	fmt.Print("\033[H\033[2J")
+}
+
+// Keys with special meaning when read with readKey
+const (
+	keyCtrlC  = 3
+	keyEscape = 27
+)
+
+// readKey reads a single keypress without waiting for Enter. When stdin is
+// not a terminal it falls back to reading a line and returning its first byte.
+func readKey() (byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !readline.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if len(line) == 0 {
+			return 0, err
+		}
+		return line[0], nil
+	}
+
+	state, err := readline.MakeRaw(fd)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read key: %v", err)
+	}
+	defer readline.Restore(fd, state)
+
+	buf := make([]byte, 1)
+	if _, err := os.Stdin.Read(buf); err != nil {
+		return 0, fmt.Errorf("failed to read key: %v", err)
+	}
+	return buf[0], nil
+}
+
+// Raw key codes used to turn Esc into "back"
+const (
+	keyEsc   = 0x1b
+	keyCtrlD = 0x04
+)
+
+// escapeReader passes stdin through to the menus, turning a lone Esc
+// keypress into Ctrl-D. promptui reports Ctrl-D on an empty line as ErrEOF,
+// which menus treat as going back a level. Keys such as the arrows also
+// start with Esc, but arrive together with the rest of their sequence.
+type escapeReader struct {
+	io.ReadCloser
+}
+
+func (r escapeReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n == 1 && p[0] == keyEsc {
+		p[0] = keyCtrlD
+	}
+	return n, err
+}
+
+// enableEscBack makes Esc leave promptui menus and prompts. Call it once at
+// startup, before the first prompt.
+func enableEscBack() {
+	readline.Stdin = escapeReader{os.Stdin}
+}
+
+// wentBack reports whether a prompt ended because the user pressed Esc (or
+// Ctrl-D) to go back, rather than failing
+func wentBack(err error) bool {
+	return errors.Is(err, promptui.ErrEOF)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"github.com/fatih/color"
+)
+
+const (
+	themeDefault    = "default"
+	themeColorBlind = "colorblind"
+)
+
+// themes lists the color themes offered under Display Options
+var themes = []string{themeDefault, themeColorBlind}
+
+// colorBlindSafe is set when the color-blind safe theme is active. It swaps
+// red and green for blue, yellow and magenta, and puts a symbol next to
+// every colored status, priority and intensity so meaning never rests on
+// color alone.
+var colorBlindSafe bool
+
+// applyTheme switches to the configured color theme
+func (c *FocusForgeCLI) applyTheme() {
+	colorBlindSafe = c.config.Theme == themeColorBlind
+}
+
+// withSymbol prefixes text with symbol when the color-blind safe theme is
+// active
+func withSymbol(symbol, text string) string {
+	if !colorBlindSafe {
+		return text
+	}
+	return symbol + " " + text
+}
+
+// statusColor returns the color used to render a task status
+func statusColor(status string) *color.Color {
+	if colorBlindSafe {
+		switch status {
+		case "completed":
+			return color.New(color.FgBlue)
+		case "in_progress":
+			return color.New(color.FgYellow)
+		default:
+			return color.New(color.FgWhite)
+		}
+	}
+	switch status {
+	case "pending":
+		return color.New(color.FgYellow)
+	case "in_progress":
+		return color.New(color.FgCyan)
+	default:
+		return color.New(color.FgGreen)
+	}
+}
+
+// statusSymbol returns the symbol shown beside a status in the color-blind
+// safe theme
+func statusSymbol(status string) string {
+	switch status {
+	case "completed":
+		return "✓"
+	case "in_progress":
+		return "●"
+	default:
+		return "○"
+	}
+}
+
+// formatStatus renders a task status in its color
+func formatStatus(status string) string {
+	return statusColor(status).Sprint(withSymbol(statusSymbol(status), status))
+}
+
+// prioritySymbol returns the symbol shown beside a priority in the
+// color-blind safe theme
+func prioritySymbol(priority string) string {
+	switch priority {
+	case "urgent":
+		return "▲▲"
+	case "high":
+		return "▲"
+	case "medium":
+		return "●"
+	default:
+		return "▽"
+	}
+}
+
+// formatPriority renders a priority label in its color
+func formatPriority(priority string) string {
+	return priorityColor(priority).Sprint(withSymbol(prioritySymbol(priority), priority))
+}
+
+// intensitySymbol returns the symbol shown beside a mood intensity in the
+// color-blind safe theme: ▽ for low, ● for medium and ▲ for high
+func intensitySymbol(intensity int) string {
+	switch {
+	case intensity <= 0:
+		return "-"
+	case intensity <= 3:
+		return "▽"
+	case intensity <= 6:
+		return "●"
+	default:
+		return "▲"
+	}
+}
+
+// intensitySwatch renders the legend sample for an intensity level: a
+// colored block, or its symbol in the color-blind safe theme
+func intensitySwatch(intensity int) string {
+	if colorBlindSafe {
+		return intensityColor(intensity).Sprint(intensitySymbol(intensity))
+	}
+	return intensityColor(intensity).Sprint("█")
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// timelineEvent is one thing that happened during a day
+type timelineEvent struct {
+	At   time.Time
+	Icon string
+	Text string
+}
+
+// dayEvents collects the events from tasks and sessions that fall within
+// [start, end), plus the day's moods, sorted by time
+func dayEvents(tasks []*Task, sessions []*Session, moods []*MoodLog, start, end time.Time, intensity func(int) string) []timelineEvent {
+	within := func(s string) (time.Time, bool) {
+		t, ok := parseTimestamp(s)
+		return t, ok && !t.Before(start) && t.Before(end)
+	}
+
+	titles := map[string]string{}
+	var events []timelineEvent
+	for _, task := range tasks {
+		titles[task.ID] = task.Title
+		if t, ok := within(task.CreatedAt); ok {
+			events = append(events, timelineEvent{t, "➕", fmt.Sprintf("Created task \"%s\"", task.Title)})
+		}
+	}
+	for _, session := range sessions {
+		title := titles[session.TaskID]
+		if title == "" {
+			title = "a task"
+		} else {
+			title = fmt.Sprintf("\"%s\"", title)
+		}
+		if t, ok := within(session.StartedAt); ok {
+			events = append(events, timelineEvent{t, "▶️ ", fmt.Sprintf("Started a %d min session on %s", session.DurationMinutes, title)})
+		}
+		if t, ok := within(session.CompletedAt); ok {
+			icon, verb := "⏹️ ", "Ended"
+			if session.Aborted {
+				icon, verb = "🛑", "Aborted"
+			}
+			events = append(events, timelineEvent{t, icon, fmt.Sprintf("%s session on %s after %d min", verb, title, session.ActualMinutes)})
+		}
+	}
+	for _, mood := range moods {
+		if t, ok := within(mood.Timestamp); ok {
+			events = append(events, timelineEvent{t, "😊", fmt.Sprintf("Felt %s %s", mood.Feeling, intensity(mood.Intensity))})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At.Before(events[j].At) })
+	return events
+}
+
+func (c *FocusForgeCLI) showDayTimeline() {
+	color.Cyan("🕰️  Day Timeline")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot load your activity")
+		fmt.Println()
+		return
+	}
+
+	stop := startSpinner("Loading tasks and sessions")
+	taskResp, taskErr := c.apiClient.GetTasks("", "", maxListLimit)
+	sessionResp, sessionErr := c.apiClient.GetSessionHistory(maxListLimit)
+	stop()
+	if taskErr != nil {
+		color.Red("❌ Failed to fetch tasks: %v", taskErr)
+		fmt.Println()
+		return
+	}
+	if sessionErr != nil {
+		color.Red("❌ Failed to fetch session history: %v", sessionErr)
+		fmt.Println()
+		return
+	}
+	if !taskResp.Success {
+		color.Red("❌ Failed to fetch tasks: %s", errorMessage(taskResp))
+		fmt.Println()
+		return
+	}
+
+	loc := c.location
+	if loc == nil {
+		loc = time.Local
+	}
+	now := time.Now().In(loc)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	for {
+		next := day.AddDate(0, 0, 1)
+
+		var moods []*MoodLog
+		if moodResp, err := c.apiClient.GetMoodLogsRange(day, next); err != nil {
+			color.Yellow("⚠️  Could not load moods: %v", err)
+		} else if moodResp.Success {
+			moods = moodResp.MoodLogs
+		}
+
+		color.Cyan("📅 %s", day.Format("Monday, Jan 2 2006"))
+		fmt.Println()
+		events := dayEvents(taskResp.Tasks, sessionResp.Sessions, moods, day, next, c.formatIntensity)
+		if len(events) == 0 {
+			dimmed.Println("  Nothing recorded on this day.")
+		}
+		for _, event := range events {
+			fmt.Printf("  %s │ %s %s\n", c.formatTime(event.At, "15:04"), event.Icon, event.Text)
+		}
+		fmt.Println()
+
+		items := []string{"◀️  Previous Day"}
+		if next.Before(now) {
+			items = append(items, "▶️  Next Day")
+		}
+		items = append(items, "📅 Pick a Date", "🔙 Back")
+		prompt := promptui.Select{
+			Label: "Navigate",
+			Items: items,
+		}
+		_, choice, err := prompt.Run()
+		if err != nil {
+			return
+		}
+
+		switch choice {
+		case "◀️  Previous Day":
+			day = day.AddDate(0, 0, -1)
+		case "▶️  Next Day":
+			day = next
+		case "📅 Pick a Date":
+			datePrompt := promptui.Prompt{
+				Label:   "Date (YYYY-MM-DD, or e.g. \"3 days ago\")",
+				Default: day.Format(dateLayout),
+				Validate: func(input string) error {
+					_, err := parseFuzzyDate(input, time.Now().In(loc))
+					return err
+				},
+			}
+			input, err := datePrompt.Run()
+			if err != nil {
+				continue
+			}
+			day, _ = parseFuzzyDate(input, time.Now().In(loc))
+		case "🔙 Back":
+			return
+		}
+		fmt.Println()
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// timestampLayouts lists the formats the backend has been seen to return.
+// Python's isoformat() omits the zone for naive datetimes, which are UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseTimestamp parses a backend timestamp, reporting false if it is empty
+// or in an unknown format
+func parseTimestamp(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// displayTimeLayout is how timestamps are shown to the user
+const displayTimeLayout = "2006-01-02 15:04 MST"
+
+// loadLocation resolves a timezone name such as "Local", "UTC" or
+// "Europe/London". An empty name means Local.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+	return loc, nil
+}
+
+// now returns the current time in the user's display timezone
+func (c *FocusForgeCLI) now() time.Time {
+	if c.location == nil {
+		return time.Now()
+	}
+	return time.Now().In(c.location)
+}
+
+// formatTime renders t in the user's display timezone
+func (c *FocusForgeCLI) formatTime(t time.Time, layout string) string {
+	loc := c.location
+	if loc == nil {
+		loc = time.Local
+	}
+	return t.In(loc).Format(layout)
+}
+
+// formatTimestamp renders a backend timestamp in the user's display
+// timezone, returning it unchanged if it cannot be parsed
+func (c *FocusForgeCLI) formatTimestamp(s string) string {
+	t, ok := parseTimestamp(s)
+	if !ok {
+		return s
+	}
+	return c.formatTime(t, displayTimeLayout)
+}
+
+// relativeTimestamp renders a recent backend timestamp relative to now, such
+// as "5m ago", falling back to the date for anything older than a week
+func (c *FocusForgeCLI) relativeTimestamp(s string) string {
+	t, ok := parseTimestamp(s)
+	if !ok {
+		return s
+	}
+
+	age := time.Since(t)
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	case age < 7*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	default:
+		return c.formatTime(t, "2006-01-02")
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// version is the CLI's release version, set at build time with
+// -ldflags "-X main.version=v1.2.3"
+var version = "v0.1.0"
+
+// defaultReleaseURL is where the latest release is looked up unless the
+// config names another URL returning the same JSON shape
+const defaultReleaseURL = "https://api.github.com/repos/douglas-danso/focusforge/releases/latest"
+
+const (
+	// updateCheckTimeout bounds the release lookup so a slow network never
+	// holds anything up
+	updateCheckTimeout = 3 * time.Second
+	// updateCheckInterval is how long a lookup result is reused
+	updateCheckInterval = 24 * time.Hour
+)
+
+// releaseInfo is the part of a GitHub release the update check reads
+type releaseInfo struct {
+	TagName string `json:"tag_name"`
+	HTMLURL string `json:"html_url"`
+}
+
+// updateCache records the last release lookup so it runs at most daily
+type updateCache struct {
+	CheckedAt time.Time   `json:"checked_at"`
+	Source    string      `json:"source"`
+	Release   releaseInfo `json:"release"`
+}
+
+// updateCachePath returns the location of the release lookup cache
+func updateCachePath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "update_check.json"), nil
+}
+
+// parseVersion splits a version like "v1.2.3" or "1.2" into its numbers,
+// ignoring any pre-release or build suffix
+func parseVersion(v string) ([]int, bool) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	if v == "" {
+		return nil, false
+	}
+	var parts []int
+	for _, field := range strings.Split(v, ".") {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		parts = append(parts, n)
+	}
+	return parts, true
+}
+
+// newerVersion reports whether latest is a higher version than current.
+// Versions that can't be parsed are never considered newer.
+func newerVersion(latest, current string) bool {
+	l, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	c, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+	for i := 0; i < len(l) || i < len(c); i++ {
+		var a, b int
+		if i < len(l) {
+			a = l[i]
+		}
+		if i < len(c) {
+			b = c[i]
+		}
+		if a != b {
+			return a > b
+		}
+	}
+	return false
+}
+
+// fetchLatestRelease looks up the latest release at url
+func fetchLatestRelease(ctx context.Context, url string) (releaseInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, updateCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return releaseInfo{}, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return releaseInfo{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return releaseInfo{}, fmt.Errorf("release lookup returned %s", resp.Status)
+	}
+
+	var release releaseInfo
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return releaseInfo{}, fmt.Errorf("failed to parse release: %v", err)
+	}
+	return release, nil
+}
+
+// latestRelease returns the latest release, from the cache when it was
+// looked up at source within the last day
+func latestRelease(ctx context.Context, source string) (releaseInfo, error) {
+	path, err := updateCachePath()
+	if err != nil {
+		return releaseInfo{}, err
+	}
+
+	var cache updateCache
+	if data, err := os.ReadFile(path); err == nil && json.Unmarshal(data, &cache) == nil {
+		if cache.Source == source && time.Since(cache.CheckedAt) < updateCheckInterval {
+			return cache.Release, nil
+		}
+	}
+
+	release, err := fetchLatestRelease(ctx, source)
+	if err != nil {
+		return releaseInfo{}, err
+	}
+
+	cache = updateCache{CheckedAt: time.Now(), Source: source, Release: release}
+	if data, err := json.MarshalIndent(cache, "", "  "); err == nil {
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+			if err := os.WriteFile(path, data, 0600); err != nil {
+				appLog.Warn("failed to cache update check", "error", err)
+			}
+		}
+	}
+	return release, nil
+}
+
+// checkForUpdate looks for a newer release and, if there is one, leaves a
+// notice for the main menu. It is meant to be run with goBackground.
+func (c *FocusForgeCLI) checkForUpdate() {
+	source := c.config.UpdateURL
+	if source == "" {
+		source = defaultReleaseURL
+	}
+
+	release, err := latestRelease(c.ctx, source)
+	if err != nil {
+		appLog.Info("update check failed", "error", err)
+		return
+	}
+	if !newerVersion(release.TagName, version) {
+		return
+	}
+
+	notice := fmt.Sprintf("🆕 Update available: %s (you have %s)", release.TagName, version)
+	if release.HTMLURL != "" {
+		notice += " - " + release.HTMLURL
+	}
+	c.mu.Lock()
+	c.updateNotice = notice
+	c.mu.Unlock()
+}
+
+// pendingUpdateNotice returns the update notice, if any
+func (c *FocusForgeCLI) pendingUpdateNotice() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.updateNotice
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// onVacation reports whether vacation mode is on at now. A vacation with an
+// end date lasts through the whole of that day.
+func (c *FocusForgeCLI) onVacation(now time.Time) bool {
+	if !c.config.Vacation {
+		return false
+	}
+	if c.config.VacationUntil == "" {
+		return true
+	}
+	until, err := parseDate(c.config.VacationUntil, c.now().Location())
+	if err != nil {
+		return true
+	}
+	return now.Before(until.AddDate(0, 0, 1))
+}
+
+// vacationBanner describes the active vacation for the main menu
+func (c *FocusForgeCLI) vacationBanner() string {
+	if c.config.VacationUntil == "" {
+		return "🌴 Vacation mode ON - reminders and streak warnings are paused"
+	}
+	return fmt.Sprintf("🌴 Vacation mode ON until %s - reminders and streak warnings are paused", c.config.VacationUntil)
+}
+
+// endExpiredVacation turns vacation mode off once its end date has passed,
+// telling the backend too
+func (c *FocusForgeCLI) endExpiredVacation() {
+	if !c.config.Vacation || c.onVacation(time.Now()) {
+		return
+	}
+
+	c.config.Vacation = false
+	c.config.VacationUntil = ""
+	if err := saveConfig(c.config); err != nil {
+		color.Yellow("⚠️  %v", err)
+	}
+	c.syncVacationMode(false)
+	color.Green("👋 Welcome back! Vacation mode is now off.")
+	fmt.Println()
+}
+
+// syncVacationMode tells the backend about a vacation mode change when it
+// supports it. While offline the change is queued and sent on reconnect;
+// other failures only warn, and the local setting still applies.
+func (c *FocusForgeCLI) syncVacationMode(on bool) {
+	if c.apiClient == nil || !c.featureEnabled(featureVacation) {
+		return
+	}
+	change := queuedMutation{Kind: mutationSetVacation, Vacation: &on}
+	if !c.isConnected() {
+		c.queueOffline(change)
+		return
+	}
+
+	resp, err := c.apiClient.SetVacationMode(on)
+	if err != nil {
+		color.Yellow("⚠️  Couldn't update vacation mode on the backend: %v", err)
+		if retryable(err) {
+			c.queueOffline(change)
+		}
+		return
+	}
+	if !resp.Success {
+		color.Yellow("⚠️  Couldn't update vacation mode on the backend: %s", errorMessage(resp))
+	}
+}
+
+func (c *FocusForgeCLI) showVacationSettings() {
+	color.Cyan("🌴 Vacation Mode")
+	fmt.Println()
+
+	fmt.Println("While you're away, vacation mode pauses mood prompts and streak and")
+	fmt.Println("commitment warnings, and asks the backend to pause your streak too.")
+	fmt.Println()
+	fmt.Printf("Vacation mode: %s\n", onOff(c.onVacation(time.Now())))
+	if c.config.Vacation && c.config.VacationUntil != "" {
+		fmt.Printf("Ends after: %s\n", c.config.VacationUntil)
+	}
+	fmt.Println()
+
+	prompt := promptui.Select{
+		Label: "Turn vacation mode on?",
+		Items: []string{"Yes", "No"},
+	}
+	_, choice, err := prompt.Run()
+	if err != nil {
+		return
+	}
+
+	on := choice == "Yes"
+	until := ""
+	if on {
+		untilPrompt := promptui.Prompt{
+			Label:   "Last day away (YYYY-MM-DD or e.g. \"tomorrow\", blank for until you turn it off)",
+			Default: c.config.VacationUntil,
+			Validate: func(input string) error {
+				if input == "" {
+					return nil
+				}
+				day, err := parseFuzzyDate(input, c.now())
+				if err != nil {
+					return err
+				}
+				if day.AddDate(0, 0, 1).Before(time.Now()) {
+					return fmt.Errorf("that day has already passed")
+				}
+				return nil
+			},
+		}
+		input, err := untilPrompt.Run()
+		if err != nil {
+			return
+		}
+		if day, err := parseFuzzyDate(input, c.now()); err == nil {
+			until = day.Format(dateLayout)
+		}
+	}
+
+	changed := on != c.config.Vacation
+	c.config.Vacation = on
+	c.config.VacationUntil = until
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+		fmt.Println()
+		return
+	}
+	if changed {
+		c.syncVacationMode(on)
+	}
+
+	color.Green("✓ Settings saved")
+	fmt.Println()
+}
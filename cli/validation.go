@@ -0,0 +1,190 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// taskPriorities lists the priorities a task can have, lowest first
+var taskPriorities = []string{"low", "medium", "high", "urgent"}
+
+// asValidationError returns err as a *ValidationError if the backend
+// rejected the input, or nil otherwise
+func asValidationError(err error) *ValidationError {
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return verr
+	}
+	return nil
+}
+
+// printValidationError lists what the backend said was wrong with each field
+func printValidationError(verr *ValidationError) {
+	color.Red("❌ The server didn't accept some of the details:")
+	for _, field := range verr.fieldNames() {
+		color.Red("   • %s: %s", field, verr.Fields[field])
+	}
+	if len(verr.Fields) == 0 && verr.Message != "" {
+		color.Red("   %s", verr.Message)
+	}
+	fmt.Println()
+}
+
+// fixTaskFields re-asks for just the task fields the backend rejected,
+// pre-filled with what was sent. It reports whether anything was changed
+// and the request should be resubmitted.
+func (c *FocusForgeCLI) fixTaskFields(req *TaskCreateRequest, verr *ValidationError) bool {
+	printValidationError(verr)
+
+	fixed := false
+	for _, field := range verr.fieldNames() {
+		if !isTaskField(field) {
+			continue
+		}
+		if err := c.editTaskField(req, field); err != nil {
+			return false
+		}
+		fixed = true
+	}
+
+	if !fixed {
+		color.Yellow("None of those can be changed here - please start over.")
+	}
+	return fixed
+}
+
+// taskFields lists the task fields that can be edited before creating a
+// task, by their API names
+var taskFields = []string{"title", "description", "duration_minutes", "category", "priority", "effort_points", "color_label"}
+
+// isTaskField reports whether field is one editTaskField can change
+func isTaskField(field string) bool {
+	for _, f := range taskFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// editTaskField asks again for one field of req, pre-filled with its
+// current value
+func (c *FocusForgeCLI) editTaskField(req *TaskCreateRequest, field string) error {
+	var err error
+	switch field {
+	case "title":
+		var title string
+		title, err = (&promptui.Prompt{
+			Label:   "Task Title",
+			Default: req.Title,
+			Validate: func(input string) error {
+				if strings.TrimSpace(input) == "" {
+					return fmt.Errorf("title cannot be empty")
+				}
+				return nil
+			},
+		}).Run()
+		if err == nil {
+			req.Title = strings.TrimSpace(title)
+		}
+	case "description":
+		var description string
+		description, err = (&promptui.Prompt{Label: "Task Description (optional)", Default: req.Description}).Run()
+		if err == nil {
+			req.Description = description
+		}
+	case "duration_minutes":
+		var duration string
+		duration, err = (&promptui.Prompt{
+			Label:    "Duration in minutes",
+			Default:  strconv.Itoa(req.DurationMinutes),
+			Validate: validateDuration,
+		}).Run()
+		if err == nil {
+			req.DurationMinutes, _ = strconv.Atoi(strings.TrimSpace(duration))
+		}
+	case "category":
+		var category string
+		category, err = c.selectCategory("Task Category", req.Category)
+		if err == nil {
+			req.Category = category
+		}
+	case "priority":
+		var priority string
+		_, priority, err = (&promptui.Select{
+			Label:     "Task Priority",
+			Items:     taskPriorities,
+			CursorPos: indexOf(taskPriorities, req.Priority),
+		}).Run()
+		if err == nil {
+			req.Priority = priority
+		}
+	case "effort_points":
+		var effort int
+		effort, err = selectEffort()
+		if err == nil {
+			req.EffortPoints = effort
+		}
+	case "color_label":
+		var label string
+		label, err = selectColorLabel()
+		if err == nil {
+			req.ColorLabel = label
+		}
+	}
+	return err
+}
+
+// fixMoodFields re-asks for just the mood fields the backend rejected. It
+// reports whether anything was changed and the mood should be resubmitted.
+func (c *FocusForgeCLI) fixMoodFields(req *MoodLogRequest, verr *ValidationError) bool {
+	printValidationError(verr)
+
+	fixed := false
+	for _, field := range verr.fieldNames() {
+		var err error
+		switch field {
+		case "feeling":
+			var mood string
+			_, mood, err = (&promptui.Select{
+				Label: "How are you feeling right now?",
+				Items: moodChoices,
+				Size:  12,
+			}).Run()
+			if err == nil {
+				req.Feeling = strings.TrimSpace(strings.Split(mood, " ")[1])
+			}
+		case "intensity":
+			cursor := req.Intensity - 1
+			if cursor < 0 {
+				cursor = 0
+			}
+			var idx int
+			idx, _, err = (&promptui.Select{
+				Label:     "How intense is this feeling? (1-10)",
+				Items:     c.intensityItems(),
+				CursorPos: cursor,
+				Size:      10,
+			}).Run()
+			req.Intensity = idx + 1
+		case "note":
+			req.Note, err = (&promptui.Prompt{Label: "Any notes about your mood? (optional)", Default: req.Note}).Run()
+		default:
+			continue
+		}
+		if err != nil {
+			return false
+		}
+		fixed = true
+	}
+
+	if !fixed {
+		color.Yellow("None of those can be changed here - please start over.")
+	}
+	return fixed
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+)
+
+// weeklyReviewSteps is how many steps the weekly review walks through
+const weeklyReviewSteps = 5
+
+// maxWeeklyPriorities caps how many priorities are set for next week
+const maxWeeklyPriorities = 3
+
+// weeklyReview is one saved weekly review
+type weeklyReview struct {
+	Date           string   `json:"date"`
+	TasksCompleted int      `json:"tasks_completed"`
+	FocusMinutes   int      `json:"focus_minutes"`
+	GoalHours      int      `json:"goal_hours,omitempty"`
+	MoodsLogged    int      `json:"moods_logged"`
+	TopMood        string   `json:"top_mood,omitempty"`
+	CarriedOver    int      `json:"carried_over"`
+	WentWell       string   `json:"went_well,omitempty"`
+	Improve        string   `json:"improve,omitempty"`
+	NextGoalHours  int      `json:"next_goal_hours,omitempty"`
+	Priorities     []string `json:"priorities,omitempty"`
+}
+
+// weeklyReviewPath returns the location of the weekly review history
+func weeklyReviewPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "reviews.jsonl"), nil
+}
+
+// saveWeeklyReview appends review to the review history
+func saveWeeklyReview(review weeklyReview) error {
+	path, err := weeklyReviewPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %v", err)
+	}
+	data, err := json.Marshal(review)
+	if err != nil {
+		return fmt.Errorf("failed to encode review: %v", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open review history: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write review history: %v", err)
+	}
+	return nil
+}
+
+// lastWeeklyReview returns the most recently saved review, if any
+func lastWeeklyReview() (weeklyReview, bool) {
+	path, err := weeklyReviewPath()
+	if err != nil {
+		return weeklyReview{}, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return weeklyReview{}, false
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		var review weeklyReview
+		if json.Unmarshal([]byte(lines[i]), &review) == nil {
+			return review, true
+		}
+	}
+	return weeklyReview{}, false
+}
+
+// reviewStep prints the heading for one step of the weekly review
+func reviewStep(n int, title string) {
+	fmt.Println()
+	color.Cyan("Step %d/%d: %s", n, weeklyReviewSteps, title)
+	fmt.Println()
+}
+
+// topMood returns the most often logged feeling in logs and the average
+// intensity across all of them
+func topMood(logs []*MoodLog) (string, float64) {
+	if len(logs) == 0 {
+		return "", 0
+	}
+	counts := map[string]int{}
+	total := 0
+	for _, entry := range logs {
+		counts[entry.Feeling]++
+		total += entry.Intensity
+	}
+	feelings := make([]string, 0, len(counts))
+	for feeling := range counts {
+		feelings = append(feelings, feeling)
+	}
+	sort.Slice(feelings, func(i, j int) bool {
+		if counts[feelings[i]] != counts[feelings[j]] {
+			return counts[feelings[i]] > counts[feelings[j]]
+		}
+		return feelings[i] < feelings[j]
+	})
+	return feelings[0], float64(total) / float64(len(logs))
+}
+
+// showWeeklyReview walks through the past seven days - completed tasks,
+// focus time against the weekly goal, mood and unfinished work - then asks
+// for reflections and next week's goal and priorities, saving the result
+func (c *FocusForgeCLI) showWeeklyReview() {
+	color.Cyan("🗓️  Weekly Review")
+	fmt.Println()
+
+	if c.apiClient == nil {
+		color.Yellow("⚠️  API client not available - cannot run a review")
+		fmt.Println()
+		return
+	}
+
+	now := c.now()
+	from := now.AddDate(0, 0, -7)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	review := weeklyReview{Date: now.Format(dateLayout), GoalHours: c.config.WeeklyFocusGoal}
+
+	if last, ok := lastWeeklyReview(); ok && len(last.Priorities) > 0 {
+		fmt.Printf("In your last review (%s) you planned to focus on:\n", last.Date)
+		for _, priority := range last.Priorities {
+			fmt.Printf("  • %s\n", priority)
+		}
+		fmt.Println()
+	}
+
+	stop := startSpinner("Gathering your week")
+	taskResp, taskErr := c.apiClient.GetTasks("", "", maxListLimit)
+	sessionResp, sessionErr := c.apiClient.GetSessionHistory(maxListLimit)
+	moodResp, moodErr := c.apiClient.GetMoodLogsRange(from, now)
+	stop()
+
+	var completed, open []*Task
+	if taskErr != nil {
+		color.Yellow("⚠️  Couldn't load tasks: %v", taskErr)
+	} else if !taskResp.Success {
+		color.Yellow("⚠️  Couldn't load tasks: %s", errorMessage(taskResp))
+	} else {
+		for _, task := range taskResp.Tasks {
+			if task.Status == "completed" && inPeriod(task.UpdatedAt, from, now) {
+				completed = append(completed, task)
+			}
+		}
+		open = overdueTasks(taskResp.Tasks, today)
+	}
+
+	// Step 1: completed tasks
+	reviewStep(1, "What you finished")
+	review.TasksCompleted = len(completed)
+	if len(completed) == 0 {
+		fmt.Println("No tasks completed this week - next week is a fresh start.")
+	} else {
+		color.Green("✓ %d task(s) completed", len(completed))
+		for _, task := range completed {
+			fmt.Printf("  • %s (%s)\n", task.Title, task.Category)
+		}
+	}
+	fmt.Println()
+	waitForEnter()
+
+	// Step 2: focus time against the goal
+	reviewStep(2, "Focus time")
+	if sessionErr != nil {
+		color.Yellow("⚠️  Couldn't load sessions: %v", sessionErr)
+	} else if !sessionResp.Success {
+		color.Yellow("⚠️  Couldn't load sessions: %s", errorMessage(sessionResp))
+	} else {
+		sessions := 0
+		for _, s := range sessionResp.Sessions {
+			if started, ok := parseTimestamp(s.StartedAt); ok && !started.Before(from) {
+				review.FocusMinutes += s.ActualMinutes
+				sessions++
+			}
+		}
+		fmt.Printf("Focused for %s across %d session(s)\n", c.formatMinutes(review.FocusMinutes), sessions)
+	}
+	if goal := c.config.WeeklyFocusGoal; goal > 0 {
+		percent := review.FocusMinutes * 100 / (goal * 60)
+		fmt.Printf("Goal: %d hours  %s %d%%\n", goal, scoreBar(percent), percent)
+		if percent >= 100 {
+			c.celebrate("Weekly focus goal reached!")
+		}
+	} else {
+		dimmed.Println("No weekly focus goal set yet - you can set one at the end of this review.")
+	}
+	fmt.Println()
+	waitForEnter()
+
+	// Step 3: mood
+	reviewStep(3, "How you felt")
+	if moodErr != nil {
+		color.Yellow("⚠️  Couldn't load moods: %v", moodErr)
+	} else if !moodResp.Success {
+		color.Yellow("⚠️  Couldn't load moods: %s", errorMessage(moodResp))
+	} else if len(moodResp.MoodLogs) == 0 {
+		fmt.Println("No moods logged this week.")
+	} else {
+		feeling, intensity := topMood(moodResp.MoodLogs)
+		review.MoodsLogged = len(moodResp.MoodLogs)
+		review.TopMood = feeling
+		fmt.Printf("%d mood(s) logged, most often %s, average intensity %.1f/10\n", review.MoodsLogged, feeling, intensity)
+	}
+	fmt.Println()
+	waitForEnter()
+
+	// Step 4: unfinished work
+	reviewStep(4, "Carrying over")
+	review.CarriedOver = len(open)
+	if len(open) == 0 {
+		color.Green("✓ Nothing overdue - a clean slate for next week")
+	} else {
+		color.Yellow("%d overdue task(s) to carry into next week:", len(open))
+		for _, task := range open {
+			fmt.Printf("  • %s\n", task.Title)
+		}
+		dimmed.Println("Use \"↪️  Carry Over\" under Task Management to move them to today.")
+	}
+	fmt.Println()
+	waitForEnter()
+
+	// Step 5: reflect and plan
+	reviewStep(5, "Reflect and plan")
+	if answer, err := (&promptui.Prompt{Label: "What went well this week? (optional)"}).Run(); err == nil {
+		review.WentWell = strings.TrimSpace(answer)
+	}
+	if answer, err := (&promptui.Prompt{Label: "What would you do differently? (optional)"}).Run(); err == nil {
+		review.Improve = strings.TrimSpace(answer)
+	}
+	if goal, ok := promptInt("Focus hours to aim for next week (0 for no goal)", c.config.WeeklyFocusGoal, 0, 168); ok {
+		review.NextGoalHours = goal
+		c.config.WeeklyFocusGoal = goal
+	}
+	for i := 1; i <= maxWeeklyPriorities; i++ {
+		answer, err := (&promptui.Prompt{Label: fmt.Sprintf("Priority %d for next week (blank to finish)", i)}).Run()
+		if err != nil || strings.TrimSpace(answer) == "" {
+			break
+		}
+		review.Priorities = append(review.Priorities, strings.TrimSpace(answer))
+	}
+
+	if err := saveConfig(c.config); err != nil {
+		color.Red("❌ Failed to save settings: %v", err)
+	}
+	if err := saveWeeklyReview(review); err != nil {
+		color.Red("❌ Failed to save review: %v", err)
+	} else {
+		color.Green("✓ Review saved - see you next week!")
+	}
+	fmt.Println()
+}